@@ -0,0 +1,4 @@
+data "snowflake_aggregation_policies" "current" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
@@ -0,0 +1,4 @@
+data "snowflake_alerts" "current" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
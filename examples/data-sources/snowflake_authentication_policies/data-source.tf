@@ -0,0 +1,4 @@
+data "snowflake_authentication_policies" "current" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
@@ -0,0 +1,3 @@
+data "snowflake_compute_pools" "current" {
+  pattern = "MY_%"
+}
@@ -0,0 +1,4 @@
+data "snowflake_cortex_search_services" "current" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
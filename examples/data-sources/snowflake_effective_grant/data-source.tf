@@ -0,0 +1,6 @@
+data "snowflake_effective_grant" "analyst_can_read" {
+  role        = "ANALYST"
+  object_type = "VIEW"
+  object_name = "MYDB.MYSCHEMA.MYVIEW"
+  privilege   = "SELECT"
+}
@@ -0,0 +1,4 @@
+data "snowflake_event_tables" "current" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
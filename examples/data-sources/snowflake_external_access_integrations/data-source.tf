@@ -0,0 +1,3 @@
+data "snowflake_external_access_integrations" "current" {
+  pattern = "MY_%"
+}
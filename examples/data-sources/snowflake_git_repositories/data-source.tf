@@ -0,0 +1,4 @@
+data "snowflake_git_repositories" "current" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
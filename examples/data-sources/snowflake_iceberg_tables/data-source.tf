@@ -0,0 +1,4 @@
+data "snowflake_iceberg_tables" "current" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
@@ -0,0 +1,4 @@
+data "snowflake_image_repositories" "current" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
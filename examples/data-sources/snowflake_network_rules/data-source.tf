@@ -0,0 +1,4 @@
+data "snowflake_network_rules" "current" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
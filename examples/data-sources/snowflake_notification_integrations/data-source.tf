@@ -0,0 +1,3 @@
+data "snowflake_notification_integrations" "current" {
+  pattern = "MY_%"
+}
@@ -0,0 +1,4 @@
+data "snowflake_projection_policies" "current" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
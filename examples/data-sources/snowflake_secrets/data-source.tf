@@ -0,0 +1,4 @@
+data "snowflake_secrets" "current" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
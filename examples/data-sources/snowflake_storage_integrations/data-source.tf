@@ -1,2 +1,6 @@
 data "snowflake_storage_integrations" "current" {
-}
\ No newline at end of file
+}
+
+data "snowflake_storage_integrations" "s3" {
+  pattern = "S3_%"
+}
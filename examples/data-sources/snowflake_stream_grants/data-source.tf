@@ -0,0 +1,11 @@
+data "snowflake_stream_grants" "stream" {
+  database_name = "MYDB"
+  schema_name   = "MYSCHEMA"
+  stream_name   = "MYSTREAM"
+}
+
+# future grants on streams in the schema
+data "snowflake_stream_grants" "future" {
+  database_name = "MYDB"
+  schema_name   = "MYSCHEMA"
+}
@@ -0,0 +1,4 @@
+data "snowflake_tag_associations" "cost_center" {
+  tag_name     = "\"MYDB\".\"MYSCHEMA\".\"COST_CENTER\""
+  object_types = ["TABLE", "COLUMN"]
+}
@@ -0,0 +1,2 @@
+# format is object type | database name | schema name | object name | policy database name | policy schema name | policy name
+terraform import snowflake_aggregation_policy_attachment.example 'TABLE|dbName|schemaName|tableName|policyDbName|policySchemaName|policyName'
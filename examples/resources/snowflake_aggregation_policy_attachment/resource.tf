@@ -0,0 +1,6 @@
+resource "snowflake_aggregation_policy_attachment" "table" {
+  aggregation_policy = "database.schema.aggregation_policy"
+  object_type        = "TABLE"
+  object_name        = "database.schema.table"
+  entity_key         = ["customer_id"]
+}
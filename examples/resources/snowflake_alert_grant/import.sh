@@ -0,0 +1,5 @@
+# format is database_name ❄️ schema_name ❄️ alert_name ❄️ privilege ❄️ with_grant_option ❄️ roles ❄️ enable_multiple_grants
+# a ❄️ within a name is written as __SNOWFLAKE__, and a comma within a role name as __COMMA__
+terraform import snowflake_alert_grant.example 'MY_DATABASE❄️MY_SCHEMA❄️MY_OBJECT❄️OPERATE❄️false❄️role1,role2❄️false'
+# future grants can also be imported by privilege alone, picking up every role holding it: database_name | schema_name | * | privilege | future
+terraform import snowflake_alert_grant.example 'MY_DATABASE|MY_SCHEMA|*|OPERATE|future'
@@ -0,0 +1,11 @@
+resource "snowflake_alert_grant" "grant" {
+  database_name = "database"
+  schema_name   = "schema"
+  alert_name    = "alert"
+
+  privilege = "OPERATE"
+  roles     = ["role1", "role2"]
+
+  on_future         = false
+  with_grant_option = false
+}
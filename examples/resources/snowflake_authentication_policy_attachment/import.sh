@@ -0,0 +1,3 @@
+# format is object type | object name | policy database name | policy schema name | policy name
+# the object name is empty for the ACCOUNT
+terraform import snowflake_authentication_policy_attachment.example 'USER|userName|policyDbName|policySchemaName|policyName'
@@ -0,0 +1,10 @@
+resource "snowflake_authentication_policy_attachment" "user" {
+  authentication_policy = "database.schema.authentication_policy"
+  object_type           = "USER"
+  object_name           = snowflake_user.user.name
+}
+
+resource "snowflake_authentication_policy_attachment" "account" {
+  authentication_policy = "database.schema.authentication_policy"
+  object_type           = "ACCOUNT"
+}
@@ -7,3 +7,11 @@ resource "snowflake_database_grant" "grant" {
 
   with_grant_option = false
 }
+
+# access to the shared SNOWFLAKE database (ACCOUNT_USAGE etc.)
+resource "snowflake_database_grant" "snowflake_usage" {
+  database_name = "SNOWFLAKE"
+
+  privilege = "IMPORTED PRIVILEGES"
+  roles     = ["role1"]
+}
@@ -1,2 +1,4 @@
 # format is database_name ❄️ schema_name ❄️ object_name ❄️ argument_data_types ❄️ privilege ❄️ with_grant_option ❄️ roles ❄️ shares
 terraform import snowflake_function_grant.example 'MY_DATABASE❄️MY_SCHEMA❄️MY_OBJECT_NAME❄️ARG1TYPE,ARG2TYPE❄️USAGE❄️false❄️role1,role2❄️share1,share2'
+# future grants can also be imported by privilege alone, picking up every role holding it: database_name | schema_name | * | privilege | future
+terraform import snowflake_function_grant.example 'MY_DATABASE|MY_SCHEMA|*|USAGE|future'
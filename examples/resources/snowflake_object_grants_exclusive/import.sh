@@ -0,0 +1,2 @@
+# format is object_type | database_name | schema_name | object_name
+terraform import snowflake_object_grants_exclusive.example 'TABLE|MY_DATABASE|MY_SCHEMA|MY_TABLE'
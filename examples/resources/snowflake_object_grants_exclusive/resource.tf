@@ -0,0 +1,17 @@
+resource "snowflake_object_grants_exclusive" "grants" {
+  object_type   = "TABLE"
+  database_name = "database"
+  schema_name   = "schema"
+  object_name   = "table"
+
+  grant {
+    privilege = "SELECT"
+    role      = "reader"
+  }
+
+  grant {
+    privilege         = "INSERT"
+    role              = "writer"
+    with_grant_option = true
+  }
+}
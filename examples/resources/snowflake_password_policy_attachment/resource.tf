@@ -0,0 +1,10 @@
+resource "snowflake_password_policy_attachment" "user" {
+  password_policy = "database.schema.password_policy"
+  object_type     = "USER"
+  object_name     = snowflake_user.user.name
+}
+
+resource "snowflake_password_policy_attachment" "account" {
+  password_policy = "database.schema.password_policy"
+  object_type     = "ACCOUNT"
+}
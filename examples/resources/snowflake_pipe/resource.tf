@@ -8,6 +8,8 @@ resource "snowflake_pipe" "pipe" {
   copy_statement = "copy into mytable from @mystage"
   auto_ingest    = false
 
-  aws_sns_topic_arn    = "..."
-  notification_channel = "..."
+  aws_sns_topic_arn = "..."
+
+  # with auto_ingest = true, subscribe the notification_channel attribute (the
+  # ARN of the SQS queue Snowflake creates) to the S3 bucket's event notifications
 }
@@ -0,0 +1,2 @@
+# format is entity type | database name | schema name | entity name | column name | policy database name | policy schema name | policy name
+terraform import snowflake_projection_policy_attachment.example 'TABLE|dbName|schemaName|tableName|columnName|policyDbName|policySchemaName|policyName'
@@ -0,0 +1,6 @@
+resource "snowflake_projection_policy_attachment" "email" {
+  projection_policy = "database.schema.projection_policy"
+  entity_type       = "TABLE"
+  entity_name       = "database.schema.table"
+  column_name       = "email"
+}
@@ -1,2 +1,4 @@
 # format is database_name ❄️ schema_name ❄️ sequence_name ❄️ privilege ❄️ with_grant_option ❄️ roles
 terraform import snowflake_schema_grant.example 'MY_DATABASE❄️MY_SCHEMA❄️MY_OBJECT❄️USAGE❄️false❄️role1,role2'
+# future grants can also be imported by privilege alone, picking up every role holding it: database_name | schema_name | * | privilege | future
+terraform import snowflake_sequence_grant.example 'MY_DATABASE|MY_SCHEMA|*|USAGE|future'
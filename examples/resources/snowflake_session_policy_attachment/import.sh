@@ -0,0 +1,2 @@
+# format is object type | object name | policy database name | policy schema name | policy name
+terraform import snowflake_session_policy_attachment.example 'USER|userName|policyDbName|policySchemaName|policyName'
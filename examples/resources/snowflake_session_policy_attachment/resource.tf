@@ -0,0 +1,5 @@
+resource "snowflake_session_policy_attachment" "attachment" {
+  session_policy = "database.schema.session_policy"
+  object_type    = "USER"
+  object_name    = snowflake_user.user.name
+}
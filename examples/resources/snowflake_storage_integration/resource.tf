@@ -9,10 +9,11 @@ resource "snowflake_storage_integration" "integration" {
   #   storage_blocked_locations = [""]
   #   storage_aws_object_acl    = "bucket-owner-full-control"
 
-  storage_provider         = "S3"
-  storage_aws_external_id  = "..."
-  storage_aws_iam_user_arn = "..."
-  storage_aws_role_arn     = "..."
+  storage_provider     = "S3"
+  storage_aws_role_arn = "..."
+
+  # the computed storage_aws_iam_user_arn and storage_aws_external_id attributes
+  # go into the trust policy of the role named by storage_aws_role_arn
 
   # azure_tenant_id
 }
@@ -1,2 +1,6 @@
-# format is database_name ❄️ schema_name ❄️ stream_name ❄️ privilege ❄️ with_grant_option ❄️ roles
-terraform import snowflake_stream_grant.example 'MY_DATABASE❄️MY_SCHEMA❄️MY_OBJECT❄️SELECT❄️false❄️role1,role2'
+# format is database_name ❄️ schema_name ❄️ stream_name ❄️ privilege ❄️ with_grant_option ❄️ roles ❄️ shares ❄️ enable_multiple_grants
+# IDs without shares or enable_multiple_grants are still accepted, the latter then keeps its configured value
+# a ❄️ within a name is written as __SNOWFLAKE__, and a comma within a role or share name as __COMMA__
+terraform import snowflake_stream_grant.example 'MY_DATABASE❄️MY_SCHEMA❄️MY_OBJECT❄️SELECT❄️false❄️role1,role2❄️❄️true'
+# future grants can also be imported by privilege alone, picking up every role holding it: database_name | schema_name | * | privilege | future
+terraform import snowflake_stream_grant.example 'MY_DATABASE|MY_SCHEMA|*|SELECT|future'
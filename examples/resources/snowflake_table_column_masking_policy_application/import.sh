@@ -0,0 +1,2 @@
+# format is database name | schema name | table name | column name | policy database name | policy schema name | policy name
+terraform import snowflake_table_column_masking_policy_application.example 'dbName|schemaName|tableName|columnName|policyDbName|policySchemaName|policyName'
@@ -0,0 +1,7 @@
+resource "snowflake_table_column_masking_policy_application" "application" {
+  database       = "database"
+  schema         = "schema"
+  table          = "table"
+  column         = "EMAIL"
+  masking_policy = snowflake_masking_policy.policy.qualified_name
+}
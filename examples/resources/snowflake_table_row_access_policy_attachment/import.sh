@@ -0,0 +1,2 @@
+# format is database name | schema name | table name | policy database name | policy schema name | policy name
+terraform import snowflake_table_row_access_policy_attachment.example 'dbName|schemaName|tableName|policyDbName|policySchemaName|policyName'
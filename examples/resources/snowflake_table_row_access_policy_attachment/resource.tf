@@ -0,0 +1,7 @@
+resource "snowflake_table_row_access_policy_attachment" "attachment" {
+  database_name          = "database"
+  schema_name            = "schema"
+  table_name             = "table"
+  row_access_policy_name = snowflake_row_access_policy.policy.id
+  argument               = ["REGION"]
+}
@@ -1,2 +1,4 @@
 # format is database name | schema name | tag name
-terraform import snowflake_tag.example 'dbName|schemaName|tagName'
\ No newline at end of file
+terraform import snowflake_tag.example 'dbName|schemaName|tagName'
+# the database and schema can be left out when the tag name is unique in the database or account
+terraform import snowflake_tag.example 'dbName|tagName'
\ No newline at end of file
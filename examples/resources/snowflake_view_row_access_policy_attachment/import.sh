@@ -0,0 +1,2 @@
+# format is database name | schema name | view name | policy database name | policy schema name | policy name
+terraform import snowflake_view_row_access_policy_attachment.example 'dbName|schemaName|viewName|policyDbName|policySchemaName|policyName'
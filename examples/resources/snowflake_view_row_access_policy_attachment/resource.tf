@@ -0,0 +1,7 @@
+resource "snowflake_view_row_access_policy_attachment" "attachment" {
+  database_name          = "database"
+  schema_name            = "schema"
+  view_name              = "view"
+  row_access_policy_name = snowflake_row_access_policy.policy.id
+  argument               = ["REGION"]
+}
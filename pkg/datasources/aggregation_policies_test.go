@@ -0,0 +1,41 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregationPoliciesRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.AggregationPolicies().Schema, map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"pattern":  "test%",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "kind", "owner", "comment", "options", "owner_role_type",
+		}).AddRow("", "test_policy", "test_db", "test_schema", "AGGREGATION_POLICY", "ACCOUNTADMIN", "great comment", "", "ROLE")
+		mock.ExpectQuery(`^SHOW AGGREGATION POLICIES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+		err := datasources.ReadAggregationPolicies(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("test_db|test_schema", d.Id())
+	r.Equal([]interface{}{map[string]interface{}{
+		"name":     "test_policy",
+		"database": "test_db",
+		"schema":   "test_schema",
+		"owner":    "ACCOUNTADMIN",
+		"comment":  "great comment",
+	}}, d.Get("aggregation_policies"))
+}
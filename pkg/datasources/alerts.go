@@ -0,0 +1,122 @@
+package datasources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var alertsSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the alerts from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the alerts from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"alerts": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The alerts in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"condition": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"action": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"state": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Whether the alert is `started` or `suspended`.",
+				},
+				"schedule": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func Alerts() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadAlerts,
+		Schema: alertsSchema,
+	}
+}
+
+func ReadAlerts(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	pattern := d.Get("pattern").(string)
+
+	currentAlerts, err := snowflake.ListAlerts(databaseName, schemaName, pattern, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] alerts in schema (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse alerts in schema (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	alerts := []map[string]interface{}{}
+
+	for _, alert := range currentAlerts {
+		alertMap := map[string]interface{}{}
+
+		alertMap["name"] = alert.Name.String
+		alertMap["database"] = alert.DatabaseName.String
+		alertMap["schema"] = alert.SchemaName.String
+		alertMap["owner"] = alert.Owner.String
+		alertMap["comment"] = alert.Comment.String
+		alertMap["condition"] = alert.Condition.String
+		alertMap["action"] = alert.Action.String
+		alertMap["state"] = alert.State.String
+		alertMap["schedule"] = alert.Schedule.String
+
+		alerts = append(alerts, alertMap)
+	}
+
+	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
+	return d.Set("alerts", alerts)
+}
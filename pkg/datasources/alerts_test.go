@@ -0,0 +1,45 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertsRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Alerts().Schema, map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"pattern":  "test%",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "owner", "comment", "warehouse", "schedule", "state", "condition", "action", "owner_role_type",
+		}).AddRow("", "test_alert", "test_db", "test_schema", "ACCOUNTADMIN", "great comment", "test_wh", "1 minute", "started", "SELECT 1", "SELECT 2", "ROLE")
+		mock.ExpectQuery(`^SHOW ALERTS LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+		err := datasources.ReadAlerts(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("test_db|test_schema", d.Id())
+	r.Equal([]interface{}{map[string]interface{}{
+		"name":      "test_alert",
+		"database":  "test_db",
+		"schema":    "test_schema",
+		"owner":     "ACCOUNTADMIN",
+		"comment":   "great comment",
+		"condition": "SELECT 1",
+		"action":    "SELECT 2",
+		"state":     "started",
+		"schedule":  "1 minute",
+	}}, d.Get("alerts"))
+}
@@ -0,0 +1,115 @@
+package datasources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var authenticationPoliciesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the authentication policies from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the authentication policies from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"authentication_policies": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The authentication policies in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"authentication_methods": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The authentication methods users can log in with, e.g. PASSWORD or SAML.",
+				},
+				"mfa_enrollment": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Whether users must enroll in multi-factor authentication (REQUIRED or OPTIONAL).",
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func AuthenticationPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadAuthenticationPolicies,
+		Schema: authenticationPoliciesSchema,
+	}
+}
+
+func ReadAuthenticationPolicies(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	pattern := d.Get("pattern").(string)
+
+	currentAuthenticationPolicies, err := snowflake.ListAuthenticationPolicies(databaseName, schemaName, pattern, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] authentication policies in schema (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse authentication policies in schema (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	authenticationPolicies := []map[string]interface{}{}
+
+	for _, policy := range currentAuthenticationPolicies {
+		policyMap := map[string]interface{}{}
+
+		policyMap["name"] = policy.Name.String
+		policyMap["database"] = policy.DatabaseName.String
+		policyMap["schema"] = policy.SchemaName.String
+		policyMap["comment"] = policy.Comment.String
+
+		// The methods and MFA enrollment are only reported by DESCRIBE AUTHENTICATION POLICY
+		description, err := snowflake.DescribeAuthenticationPolicy(databaseName, schemaName, policy.Name.String, db)
+		if err != nil {
+			return fmt.Errorf("unable to describe authentication policy %v: %w", policy.Name.String, err)
+		}
+		policyMap["authentication_methods"] = description.AuthenticationMethods
+		policyMap["mfa_enrollment"] = description.MfaEnrollment
+
+		authenticationPolicies = append(authenticationPolicies, policyMap)
+	}
+
+	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
+	return d.Set("authentication_policies", authenticationPolicies)
+}
@@ -0,0 +1,48 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticationPoliciesRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.AuthenticationPolicies().Schema, map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"pattern":  "test%",
+	})
+
+	// the methods and MFA enrollment are only reported by DESCRIBE AUTHENTICATION POLICY
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "comment", "database_name", "schema_name", "owner", "owner_role_type", "options",
+		}).AddRow("", "test_policy", "great comment", "test_db", "test_schema", "ACCOUNTADMIN", "ROLE", "")
+		mock.ExpectQuery(`^SHOW AUTHENTICATION POLICIES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+		mock.ExpectQuery(`^DESCRIBE AUTHENTICATION POLICY "test_db"."test_schema"."test_policy"$`).WillReturnRows(sqlmock.NewRows([]string{"property", "value", "default", "description"}).
+			AddRow("NAME", "test_policy", "", "").
+			AddRow("AUTHENTICATION_METHODS", "[PASSWORD, SAML]", "[ALL]", "").
+			AddRow("MFA_ENROLLMENT", "REQUIRED", "OPTIONAL", "").
+			AddRow("COMMENT", "great comment", "", ""))
+
+		err := datasources.ReadAuthenticationPolicies(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("test_db|test_schema", d.Id())
+	r.Equal([]interface{}{map[string]interface{}{
+		"name":                   "test_policy",
+		"database":               "test_db",
+		"schema":                 "test_schema",
+		"comment":                "great comment",
+		"authentication_methods": []interface{}{"PASSWORD", "SAML"},
+		"mfa_enrollment":         "REQUIRED",
+	}}, d.Get("authentication_policies"))
+}
@@ -0,0 +1,113 @@
+package datasources
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var computePoolsSchema = map[string]*schema.Schema{
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"compute_pools": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The compute pools in the account",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"min_nodes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"max_nodes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"instance_family": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"num_services": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"auto_resume": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"auto_suspend_secs": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func ComputePools() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadComputePools,
+		Schema: computePoolsSchema,
+	}
+}
+
+func ReadComputePools(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	pattern := d.Get("pattern").(string)
+
+	currentComputePools, err := snowflake.ListComputePools(pattern, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] compute pools in account (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse compute pools in account (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	computePools := []map[string]interface{}{}
+
+	for _, pool := range currentComputePools {
+		poolMap := map[string]interface{}{}
+
+		poolMap["name"] = pool.Name.String
+		poolMap["state"] = pool.State.String
+		poolMap["min_nodes"] = pool.MinNodes.Int64
+		poolMap["max_nodes"] = pool.MaxNodes.Int64
+		poolMap["instance_family"] = pool.InstanceFamily.String
+		poolMap["num_services"] = pool.NumServices.Int64
+		poolMap["auto_resume"] = pool.AutoResume.Bool
+		poolMap["auto_suspend_secs"] = pool.AutoSuspendSecs.Int64
+		poolMap["owner"] = pool.Owner.String
+		poolMap["comment"] = pool.Comment.String
+
+		computePools = append(computePools, poolMap)
+	}
+
+	d.SetId("compute_pools")
+	return d.Set("compute_pools", computePools)
+}
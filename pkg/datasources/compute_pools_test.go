@@ -0,0 +1,44 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputePoolsRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.ComputePools().Schema, map[string]interface{}{
+		"pattern": "test%",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"name", "state", "min_nodes", "max_nodes", "instance_family", "num_services", "num_jobs", "auto_suspend_secs", "auto_resume", "owner", "comment",
+		}).AddRow("TEST_POOL", "ACTIVE", 1, 3, "CPU_X64_XS", 2, 0, 3600, true, "ACCOUNTADMIN", "great comment")
+		mock.ExpectQuery(`^SHOW COMPUTE POOLS LIKE 'test%'$`).WillReturnRows(rows)
+
+		err := datasources.ReadComputePools(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("compute_pools", d.Id())
+	r.Equal([]interface{}{map[string]interface{}{
+		"name":              "TEST_POOL",
+		"state":             "ACTIVE",
+		"min_nodes":         1,
+		"max_nodes":         3,
+		"instance_family":   "CPU_X64_XS",
+		"num_services":      2,
+		"auto_resume":       true,
+		"auto_suspend_secs": 3600,
+		"owner":             "ACCOUNTADMIN",
+		"comment":           "great comment",
+	}}, d.Get("compute_pools"))
+}
@@ -0,0 +1,117 @@
+package datasources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var cortexSearchServicesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the cortex search services from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the cortex search services from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"cortex_search_services": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The cortex search services in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"warehouse": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"target_lag": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"on": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The column the service searches on.",
+				},
+				"embedding_model": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func CortexSearchServices() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadCortexSearchServices,
+		Schema: cortexSearchServicesSchema,
+	}
+}
+
+func ReadCortexSearchServices(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	pattern := d.Get("pattern").(string)
+
+	currentCortexSearchServices, err := snowflake.ListCortexSearchServices(databaseName, schemaName, pattern, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] cortex search services in schema (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse cortex search services in schema (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	cortexSearchServices := []map[string]interface{}{}
+
+	for _, service := range currentCortexSearchServices {
+		serviceMap := map[string]interface{}{}
+
+		serviceMap["name"] = service.Name.String
+		serviceMap["database"] = service.DatabaseName.String
+		serviceMap["schema"] = service.SchemaName.String
+		serviceMap["warehouse"] = service.Warehouse.String
+		serviceMap["target_lag"] = service.TargetLag.String
+		serviceMap["on"] = service.SearchColumn.String
+		serviceMap["embedding_model"] = service.EmbeddingModel.String
+		serviceMap["comment"] = service.Comment.String
+
+		cortexSearchServices = append(cortexSearchServices, serviceMap)
+	}
+
+	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
+	return d.Set("cortex_search_services", cortexSearchServices)
+}
@@ -0,0 +1,44 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCortexSearchServicesRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.CortexSearchServices().Schema, map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"pattern":  "test%",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "warehouse", "target_lag", "search_column", "embedding_model", "comment",
+		}).AddRow("", "test_service", "test_db", "test_schema", "test_wh", "1 hour", "TRANSCRIPT", "snowflake-arctic-embed-m-v1.5", "great comment")
+		mock.ExpectQuery(`^SHOW CORTEX SEARCH SERVICES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+		err := datasources.ReadCortexSearchServices(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("test_db|test_schema", d.Id())
+	r.Equal([]interface{}{map[string]interface{}{
+		"name":            "test_service",
+		"database":        "test_db",
+		"schema":          "test_schema",
+		"warehouse":       "test_wh",
+		"target_lag":      "1 hour",
+		"on":              "TRANSCRIPT",
+		"embedding_model": "snowflake-arctic-embed-m-v1.5",
+		"comment":         "great comment",
+	}}, d.Get("cortex_search_services"))
+}
@@ -0,0 +1,87 @@
+package datasources
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var effectiveGrantSchema = map[string]*schema.Schema{
+	"role": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The role whose access is checked.",
+	},
+	"object_type": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The type of the object, e.g. VIEW or MATERIALIZED VIEW.",
+	},
+	"object_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The fully qualified name of the object, e.g. DB.SCHEMA.VIEW. As in Snowflake, unquoted parts are case-insensitive and quoted parts, e.g. DB.SCHEMA.\"MyView\", are compared exactly.",
+	},
+	"privilege": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The privilege to check for, e.g. SELECT. OWNERSHIP of the object implies every privilege.",
+	},
+	"granted": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the role holds the privilege on the object, directly or through the roles granted to it (including PUBLIC). Database roles are not followed.",
+	},
+	"granted_privilege": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The privilege that was found, which is OWNERSHIP when the privilege is implied by ownership.",
+	},
+	"direct": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the privilege is granted to the role itself rather than inherited.",
+	},
+	"path": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The roles from `role` to the role holding the privilege. Empty when the privilege is not held.",
+	},
+}
+
+// EffectiveGrant Snowflake effective grant data source.
+func EffectiveGrant() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadEffectiveGrant,
+		Schema: effectiveGrantSchema,
+	}
+}
+
+// ReadEffectiveGrant resolves whether the role holds the privilege on the object.
+func ReadEffectiveGrant(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	role := d.Get("role").(string)
+	objectType := d.Get("object_type").(string)
+	objectName := d.Get("object_name").(string)
+	privilege := d.Get("privilege").(string)
+
+	grant, err := snowflake.ResolveEffectiveGrant(db, role, objectType, objectName, privilege)
+	if err != nil {
+		return fmt.Errorf("unable to resolve %v on %v %v for role %v: %w", privilege, objectType, objectName, role, err)
+	}
+
+	d.SetId(fmt.Sprintf(`%v|%v|%v|%v`, role, objectType, objectName, privilege))
+	if err := d.Set("granted", grant.Granted); err != nil {
+		return err
+	}
+	if err := d.Set("granted_privilege", grant.Privilege); err != nil {
+		return err
+	}
+	if err := d.Set("direct", grant.Granted && len(grant.Path) == 1); err != nil {
+		return err
+	}
+	return d.Set("path", grant.Path)
+}
@@ -0,0 +1,68 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_EffectiveGrant(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	parentRole := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	childRole := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: effectiveGrant(databaseName, parentRole, childRole),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_effective_grant.t", "granted", "true"),
+					resource.TestCheckResourceAttr("data.snowflake_effective_grant.t", "direct", "false"),
+					resource.TestCheckResourceAttr("data.snowflake_effective_grant.t", "granted_privilege", "USAGE"),
+					resource.TestCheckResourceAttr("data.snowflake_effective_grant.t", "path.#", "2"),
+					resource.TestCheckResourceAttr("data.snowflake_effective_grant.t", "path.0", parentRole),
+					resource.TestCheckResourceAttr("data.snowflake_effective_grant.t", "path.1", childRole),
+				),
+			},
+		},
+	})
+}
+
+func effectiveGrant(databaseName, parentRole, childRole string) string {
+	return fmt.Sprintf(`
+	resource snowflake_database "d" {
+		name = "%v"
+	}
+
+	resource snowflake_role "parent" {
+		name = "%v"
+	}
+
+	resource snowflake_role "child" {
+		name = "%v"
+	}
+
+	resource snowflake_role_grants "g" {
+		role_name = snowflake_role.child.name
+		roles     = [snowflake_role.parent.name]
+	}
+
+	resource snowflake_database_grant "g" {
+		database_name = snowflake_database.d.name
+		privilege     = "USAGE"
+		roles         = [snowflake_role.child.name]
+	}
+
+	data snowflake_effective_grant "t" {
+		depends_on  = [snowflake_role_grants.g, snowflake_database_grant.g]
+		role        = snowflake_role.parent.name
+		object_type = "DATABASE"
+		object_name = snowflake_database.d.name
+		privilege   = "USAGE"
+	}
+	`, databaseName, parentRole, childRole)
+}
@@ -0,0 +1,111 @@
+package datasources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var eventTablesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the event tables from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the event tables from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"event_tables": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The event tables in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"rows": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"bytes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func EventTables() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadEventTables,
+		Schema: eventTablesSchema,
+	}
+}
+
+func ReadEventTables(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	pattern := d.Get("pattern").(string)
+
+	currentEventTables, err := snowflake.ListEventTables(databaseName, schemaName, pattern, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] event tables in schema (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse event tables in schema (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	eventTables := []map[string]interface{}{}
+
+	for _, table := range currentEventTables {
+		tableMap := map[string]interface{}{}
+
+		tableMap["name"] = table.Name.String
+		tableMap["database"] = table.DatabaseName.String
+		tableMap["schema"] = table.SchemaName.String
+		tableMap["owner"] = table.Owner.String
+		tableMap["comment"] = table.Comment.String
+		tableMap["rows"] = table.Rows.Int64
+		tableMap["bytes"] = table.Bytes.Int64
+
+		eventTables = append(eventTables, tableMap)
+	}
+
+	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
+	return d.Set("event_tables", eventTables)
+}
@@ -0,0 +1,43 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventTablesRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.EventTables().Schema, map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"pattern":  "test%",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "owner", "comment", "rows", "bytes",
+		}).AddRow("", "test_events", "test_db", "test_schema", "ACCOUNTADMIN", "great comment", 10, 1024)
+		mock.ExpectQuery(`^SHOW EVENT TABLES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+		err := datasources.ReadEventTables(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("test_db|test_schema", d.Id())
+	r.Equal([]interface{}{map[string]interface{}{
+		"name":     "test_events",
+		"database": "test_db",
+		"schema":   "test_schema",
+		"owner":    "ACCOUNTADMIN",
+		"comment":  "great comment",
+		"rows":     10,
+		"bytes":    1024,
+	}}, d.Get("event_tables"))
+}
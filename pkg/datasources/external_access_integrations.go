@@ -0,0 +1,91 @@
+package datasources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var externalAccessIntegrationsSchema = map[string]*schema.Schema{
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"external_access_integrations": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The external access integrations in the account",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"allowed_network_rules": {
+					Type:     schema.TypeList,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func ExternalAccessIntegrations() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadExternalAccessIntegrations,
+		Schema: externalAccessIntegrationsSchema,
+	}
+}
+
+func ReadExternalAccessIntegrations(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	pattern := d.Get("pattern").(string)
+
+	currentExternalAccessIntegrations, err := snowflake.ListExternalAccessIntegrations(pattern, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] external access integrations in account (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse external access integrations in account (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	externalAccessIntegrations := []map[string]interface{}{}
+
+	for _, integration := range currentExternalAccessIntegrations {
+		integrationMap := map[string]interface{}{}
+
+		integrationMap["name"] = integration.Name.String
+		integrationMap["enabled"] = integration.Enabled.Bool
+		integrationMap["comment"] = integration.Comment.String
+
+		// The network rules are only reported by DESCRIBE INTEGRATION
+		rules, err := snowflake.DescribeExternalAccessIntegrationNetworkRules(integration.Name.String, db)
+		if err != nil {
+			return fmt.Errorf("unable to describe external access integration %v: %w", integration.Name.String, err)
+		}
+		integrationMap["allowed_network_rules"] = rules
+
+		externalAccessIntegrations = append(externalAccessIntegrations, integrationMap)
+	}
+
+	d.SetId("external_access_integrations")
+	return d.Set("external_access_integrations", externalAccessIntegrations)
+}
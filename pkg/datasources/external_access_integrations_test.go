@@ -0,0 +1,42 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalAccessIntegrationsRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.ExternalAccessIntegrations().Schema, map[string]interface{}{
+		"pattern": "test%",
+	})
+
+	// the network rules are only reported by DESCRIBE INTEGRATION
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"name", "type", "category", "enabled", "comment", "created_on",
+		}).AddRow("TEST_EAI", "EXTERNAL_ACCESS", "SECURITY", true, "great comment", "")
+		mock.ExpectQuery(`^SHOW EXTERNAL ACCESS INTEGRATIONS LIKE 'test%'$`).WillReturnRows(rows)
+		mock.ExpectQuery(`^DESCRIBE INTEGRATION "TEST_EAI"$`).WillReturnRows(sqlmock.NewRows([]string{"property", "property_type", "property_value", "property_default"}).
+			AddRow("ENABLED", "Boolean", "true", "false").
+			AddRow("ALLOWED_NETWORK_RULES", "List", "[DB.SCHEMA.RULE_A, DB.SCHEMA.RULE_B]", "[]"))
+
+		err := datasources.ReadExternalAccessIntegrations(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("external_access_integrations", d.Id())
+	r.Equal([]interface{}{map[string]interface{}{
+		"name":                  "TEST_EAI",
+		"enabled":               true,
+		"comment":               "great comment",
+		"allowed_network_rules": []interface{}{"DB.SCHEMA.RULE_A", "DB.SCHEMA.RULE_B"},
+	}}, d.Get("external_access_integrations"))
+}
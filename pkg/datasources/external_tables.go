@@ -21,6 +21,11 @@ var externalTablesSchema = map[string]*schema.Schema{
 		Required:    true,
 		Description: "The schema from which to return the external tables from.",
 	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
 	"external_tables": {
 		Type:        schema.TypeList,
 		Computed:    true,
@@ -44,6 +49,22 @@ var externalTablesSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"invalid_reason": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"location": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"file_format_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 			},
 		},
 	},
@@ -60,8 +81,9 @@ func ReadExternalTables(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
+	pattern := d.Get("pattern").(string)
 
-	currentExternalTables, err := snowflake.ListExternalTables(databaseName, schemaName, db)
+	currentExternalTables, err := snowflake.ListExternalTables(databaseName, schemaName, pattern, db)
 	if errors.Is(err, sql.ErrNoRows) {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] external tables in schema (%s) not found", d.Id())
@@ -82,6 +104,10 @@ func ReadExternalTables(d *schema.ResourceData, meta interface{}) error {
 		externalTableMap["database"] = externalTable.DatabaseName.String
 		externalTableMap["schema"] = externalTable.SchemaName.String
 		externalTableMap["comment"] = externalTable.Comment.String
+		externalTableMap["owner"] = externalTable.Owner.String
+		externalTableMap["invalid_reason"] = externalTable.InvalidReason.String
+		externalTableMap["location"] = externalTable.Location.String
+		externalTableMap["file_format_type"] = externalTable.FileFormatType.String
 
 		externalTables = append(externalTables, externalTableMap)
 	}
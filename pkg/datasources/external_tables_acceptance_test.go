@@ -31,6 +31,10 @@ func TestAcc_ExternalTables(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.snowflake_external_tables.t", "external_tables.#"),
 					resource.TestCheckResourceAttr("data.snowflake_external_tables.t", "external_tables.#", "1"),
 					resource.TestCheckResourceAttr("data.snowflake_external_tables.t", "external_tables.0.name", externalTableName),
+					resource.TestCheckResourceAttrSet("data.snowflake_external_tables.t", "external_tables.0.owner"),
+					resource.TestCheckResourceAttrSet("data.snowflake_external_tables.t", "external_tables.0.location"),
+					resource.TestCheckResourceAttr("data.snowflake_external_tables.t", "external_tables.0.file_format_type", "CSV"),
+					resource.TestCheckResourceAttr("data.snowflake_external_tables.filtered", "external_tables.#", "1"),
 				),
 			},
 		},
@@ -76,5 +80,12 @@ func externalTables(databaseName string, schemaName string, stageName string, ex
 		schema = snowflake_external_table.test_table.schema
 		depends_on = [snowflake_external_table.test_table]
 	}
+
+	data snowflake_external_tables "filtered" {
+		database = snowflake_external_table.test_table.database
+		schema = snowflake_external_table.test_table.schema
+		pattern = snowflake_external_table.test_table.name
+		depends_on = [snowflake_external_table.test_table]
+	}
 	`, databaseName, schemaName, stageName, externalTableName)
 }
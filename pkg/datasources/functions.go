@@ -2,6 +2,8 @@ package datasources
 
 import (
 	"database/sql"
+	"errors"
+	"fmt"
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
@@ -19,6 +21,11 @@ var functionsSchema = map[string]*schema.Schema{
 		Required:    true,
 		Description: "The schema from which to return the functions from.",
 	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
 	"functions": {
 		Type:        schema.TypeList,
 		Computed:    true,
@@ -42,6 +49,15 @@ var functionsSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"language": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"arguments": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The signature of the function as reported by Snowflake, e.g. `ADD(NUMBER, NUMBER) RETURN NUMBER`.",
+				},
 				"argument_types": {
 					Type:     schema.TypeList,
 					Elem:     &schema.Schema{Type: schema.TypeString},
@@ -53,6 +69,14 @@ var functionsSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"is_secure": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"is_external": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
 			},
 		},
 	},
@@ -65,16 +89,21 @@ func Functions() *schema.Resource {
 	}
 }
 
-// todo: fix this. ListUserFunctions isn't using the right struct right now and also the signature of this doesn't support all the features it could for example, database and schema should be optional, and you could also list by account.
 func ReadFunctions(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
+	pattern := d.Get("pattern").(string)
 
-	d.SetId("functions")
-	currentFunctions, err := snowflake.ListUserFunctions(databaseName, schemaName, db)
-	if err != nil {
-		log.Printf("[DEBUG] error listing functions: %v", err)
+	currentFunctions, err := snowflake.ListUserFunctions(databaseName, schemaName, pattern, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] functions in schema (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse functions in schema (%s)", d.Id())
+		d.SetId("")
 		return nil
 	}
 
@@ -92,11 +121,16 @@ func ReadFunctions(d *schema.ResourceData, meta interface{}) error {
 		functionMap["database"] = databaseName
 		functionMap["schema"] = schemaName
 		functionMap["comment"] = function.Description.String
+		functionMap["language"] = function.Language.String
+		functionMap["arguments"] = function.Arguments.String
 		functionMap["argument_types"] = functionSignatureMap["argumentTypes"].([]string)
 		functionMap["return_type"] = functionSignatureMap["returnType"].(string)
+		functionMap["is_secure"] = function.IsSecure.String == "Y"
+		functionMap["is_external"] = function.IsExternalFunction.String == "Y"
 
 		functions = append(functions, functionMap)
 	}
 
+	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
 	return d.Set("functions", functions)
 }
@@ -22,6 +22,12 @@ func TestAcc_Functions(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.snowflake_functions.t", "database", databaseName),
 					resource.TestCheckResourceAttr("data.snowflake_functions.t", "schema", schemaName),
+					resource.TestCheckResourceAttrSet("data.snowflake_functions.t", "functions.#"),
+					resource.TestCheckResourceAttr("data.snowflake_functions.filtered", "functions.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_functions.filtered", "functions.0.name", functionName),
+					resource.TestCheckResourceAttr("data.snowflake_functions.filtered", "functions.0.language", "SQL"),
+					resource.TestCheckResourceAttr("data.snowflake_functions.filtered", "functions.0.is_secure", "false"),
+					resource.TestCheckResourceAttr("data.snowflake_functions.filtered", "functions.0.is_external", "false"),
 				),
 			},
 		},
@@ -52,6 +58,13 @@ data snowflake_functions "t" {
 	schema = snowflake_schema.test_schema.name
 	depends_on = [snowflake_function.test_funct_simple]
 }
+
+data snowflake_functions "filtered" {
+	database = snowflake_database.test_database.name
+	schema = snowflake_schema.test_schema.name
+	pattern = "%s"
+	depends_on = [snowflake_function.test_funct_simple]
+}
 `
-	return fmt.Sprintf(s, databaseName, schemaName, functionName)
+	return fmt.Sprintf(s, databaseName, schemaName, functionName, functionName)
 }
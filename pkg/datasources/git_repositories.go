@@ -0,0 +1,111 @@
+package datasources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var gitRepositoriesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the git repositories from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the git repositories from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"git_repositories": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The git repositories in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"origin": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"api_integration": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func GitRepositories() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadGitRepositories,
+		Schema: gitRepositoriesSchema,
+	}
+}
+
+func ReadGitRepositories(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	pattern := d.Get("pattern").(string)
+
+	currentGitRepositories, err := snowflake.ListGitRepositories(databaseName, schemaName, pattern, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] git repositories in schema (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse git repositories in schema (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	gitRepositories := []map[string]interface{}{}
+
+	for _, repository := range currentGitRepositories {
+		repositoryMap := map[string]interface{}{}
+
+		repositoryMap["name"] = repository.Name.String
+		repositoryMap["database"] = repository.DatabaseName.String
+		repositoryMap["schema"] = repository.SchemaName.String
+		repositoryMap["origin"] = repository.Origin.String
+		repositoryMap["api_integration"] = repository.APIIntegration.String
+		repositoryMap["owner"] = repository.Owner.String
+		repositoryMap["comment"] = repository.Comment.String
+
+		gitRepositories = append(gitRepositories, repositoryMap)
+	}
+
+	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
+	return d.Set("git_repositories", gitRepositories)
+}
@@ -0,0 +1,43 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitRepositoriesRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.GitRepositories().Schema, map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"pattern":  "test%",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "origin", "api_integration", "git_credentials", "owner", "comment",
+		}).AddRow("", "test_repo", "test_db", "test_schema", "https://github.com/example/repo.git", "GIT_API", "", "ACCOUNTADMIN", "great comment")
+		mock.ExpectQuery(`^SHOW GIT REPOSITORIES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+		err := datasources.ReadGitRepositories(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("test_db|test_schema", d.Id())
+	r.Equal([]interface{}{map[string]interface{}{
+		"name":            "test_repo",
+		"database":        "test_db",
+		"schema":          "test_schema",
+		"origin":          "https://github.com/example/repo.git",
+		"api_integration": "GIT_API",
+		"owner":           "ACCOUNTADMIN",
+		"comment":         "great comment",
+	}}, d.Get("git_repositories"))
+}
@@ -0,0 +1,119 @@
+package datasources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var icebergTablesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the iceberg tables from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the iceberg tables from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"iceberg_tables": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The iceberg tables in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"catalog": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The catalog integration, or SNOWFLAKE for Snowflake-managed tables.",
+				},
+				"external_volume": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The external volume the table data and metadata are stored on.",
+				},
+				"base_location": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The path to the table files relative to the external volume.",
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func IcebergTables() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadIcebergTables,
+		Schema: icebergTablesSchema,
+	}
+}
+
+func ReadIcebergTables(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	pattern := d.Get("pattern").(string)
+
+	currentIcebergTables, err := snowflake.ListIcebergTables(databaseName, schemaName, pattern, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] iceberg tables in schema (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse iceberg tables in schema (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	icebergTables := []map[string]interface{}{}
+
+	for _, table := range currentIcebergTables {
+		tableMap := map[string]interface{}{}
+
+		tableMap["name"] = table.Name.String
+		tableMap["database"] = table.DatabaseName.String
+		tableMap["schema"] = table.SchemaName.String
+		tableMap["catalog"] = table.CatalogName.String
+		tableMap["external_volume"] = table.ExternalVolumeName.String
+		tableMap["base_location"] = table.BaseLocation.String
+		tableMap["owner"] = table.Owner.String
+		tableMap["comment"] = table.Comment.String
+
+		icebergTables = append(icebergTables, tableMap)
+	}
+
+	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
+	return d.Set("iceberg_tables", icebergTables)
+}
@@ -0,0 +1,44 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIcebergTablesRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.IcebergTables().Schema, map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"pattern":  "test%",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "owner", "comment", "external_volume_name", "catalog_name", "iceberg_table_type", "catalog_table_name", "catalog_namespace", "base_location",
+		}).AddRow("", "test_table", "test_db", "test_schema", "ACCOUNTADMIN", "great comment", "TEST_VOLUME", "SNOWFLAKE", "MANAGED", "", "", "test_table/")
+		mock.ExpectQuery(`^SHOW ICEBERG TABLES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+		err := datasources.ReadIcebergTables(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("test_db|test_schema", d.Id())
+	r.Equal([]interface{}{map[string]interface{}{
+		"name":            "test_table",
+		"database":        "test_db",
+		"schema":          "test_schema",
+		"catalog":         "SNOWFLAKE",
+		"external_volume": "TEST_VOLUME",
+		"base_location":   "test_table/",
+		"owner":           "ACCOUNTADMIN",
+		"comment":         "great comment",
+	}}, d.Get("iceberg_tables"))
+}
@@ -0,0 +1,106 @@
+package datasources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var imageRepositoriesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the image repositories from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the image repositories from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"image_repositories": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The image repositories in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"repository_url": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func ImageRepositories() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadImageRepositories,
+		Schema: imageRepositoriesSchema,
+	}
+}
+
+func ReadImageRepositories(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	pattern := d.Get("pattern").(string)
+
+	currentImageRepositories, err := snowflake.ListImageRepositories(databaseName, schemaName, pattern, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] image repositories in schema (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse image repositories in schema (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	imageRepositories := []map[string]interface{}{}
+
+	for _, repository := range currentImageRepositories {
+		repositoryMap := map[string]interface{}{}
+
+		repositoryMap["name"] = repository.Name.String
+		repositoryMap["database"] = repository.DatabaseName.String
+		repositoryMap["schema"] = repository.SchemaName.String
+		repositoryMap["repository_url"] = repository.RepositoryURL.String
+		repositoryMap["owner"] = repository.Owner.String
+		repositoryMap["comment"] = repository.Comment.String
+
+		imageRepositories = append(imageRepositories, repositoryMap)
+	}
+
+	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
+	return d.Set("image_repositories", imageRepositories)
+}
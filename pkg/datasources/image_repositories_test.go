@@ -0,0 +1,42 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageRepositoriesRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.ImageRepositories().Schema, map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"pattern":  "test%",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "repository_url", "owner", "comment",
+		}).AddRow("", "test_repo", "test_db", "test_schema", "org-acct.registry.snowflakecomputing.com/test_db/test_schema/test_repo", "ACCOUNTADMIN", "great comment")
+		mock.ExpectQuery(`^SHOW IMAGE REPOSITORIES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+		err := datasources.ReadImageRepositories(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("test_db|test_schema", d.Id())
+	r.Equal([]interface{}{map[string]interface{}{
+		"name":           "test_repo",
+		"database":       "test_db",
+		"schema":         "test_schema",
+		"repository_url": "org-acct.registry.snowflakecomputing.com/test_db/test_schema/test_repo",
+		"owner":          "ACCOUNTADMIN",
+		"comment":        "great comment",
+	}}, d.Get("image_repositories"))
+}
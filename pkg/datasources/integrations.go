@@ -0,0 +1,101 @@
+package datasources
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var integrationsSchema = map[string]*schema.Schema{
+	"integration_type": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Only returns integrations of this type. One of API, NOTIFICATION, SECURITY, STORAGE or EXTERNAL ACCESS.",
+		ValidateFunc: validation.StringInSlice([]string{"API", "NOTIFICATION", "SECURITY", "STORAGE", "EXTERNAL ACCESS"}, true),
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"integrations": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The integrations in the account",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"category": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func Integrations() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadIntegrations,
+		Schema: integrationsSchema,
+	}
+}
+
+func ReadIntegrations(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	integrationType := d.Get("integration_type").(string)
+	pattern := d.Get("pattern").(string)
+
+	currentIntegrations, err := snowflake.ListIntegrations(integrationType, pattern, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] integrations in account (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse integrations in account (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	integrations := []map[string]interface{}{}
+
+	for _, integration := range currentIntegrations {
+		integrationMap := map[string]interface{}{}
+
+		integrationMap["name"] = integration.Name.String
+		integrationMap["type"] = integration.Type.String
+		integrationMap["category"] = integration.Category.String
+		integrationMap["enabled"] = integration.Enabled.Bool
+		integrationMap["comment"] = integration.Comment.String
+		integrationMap["created_on"] = integration.CreatedOn.String
+
+		integrations = append(integrations, integrationMap)
+	}
+
+	d.SetId("integrations")
+	return d.Set("integrations", integrations)
+}
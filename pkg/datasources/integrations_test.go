@@ -0,0 +1,41 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegrationsRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Integrations().Schema, map[string]interface{}{
+		"integration_type": "external access",
+		"pattern":          "test%",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"name", "type", "category", "enabled", "comment", "created_on",
+		}).AddRow("TEST_INTEGRATION", "EXTERNAL_ACCESS", "SECURITY", true, "great comment", "2023-01-01 00:00:00.000 -0800")
+		mock.ExpectQuery(`^SHOW EXTERNAL ACCESS INTEGRATIONS LIKE 'test%'$`).WillReturnRows(rows)
+
+		err := datasources.ReadIntegrations(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("integrations", d.Id())
+	r.Equal([]interface{}{map[string]interface{}{
+		"name":       "TEST_INTEGRATION",
+		"type":       "EXTERNAL_ACCESS",
+		"category":   "SECURITY",
+		"enabled":    true,
+		"comment":    "great comment",
+		"created_on": "2023-01-01 00:00:00.000 -0800",
+	}}, d.Get("integrations"))
+}
@@ -21,6 +21,11 @@ var materializedViewsSchema = map[string]*schema.Schema{
 		Required:    true,
 		Description: "The schema from which to return the views from.",
 	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
 	"materialized_views": {
 		Type:        schema.TypeList,
 		Computed:    true,
@@ -44,6 +49,30 @@ var materializedViewsSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"is_secure": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"cluster_by": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"rows": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"bytes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"invalid_reason": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 			},
 		},
 	},
@@ -60,8 +89,9 @@ func ReadMaterializedViews(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
+	pattern := d.Get("pattern").(string)
 
-	currentViews, err := snowflake.ListMaterializedViews(databaseName, schemaName, db)
+	currentViews, err := snowflake.ListMaterializedViews(databaseName, schemaName, pattern, db)
 	if errors.Is(err, sql.ErrNoRows) {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] materialized views in schema (%s) not found", d.Id())
@@ -82,6 +112,12 @@ func ReadMaterializedViews(d *schema.ResourceData, meta interface{}) error {
 		viewMap["database"] = view.DatabaseName.String
 		viewMap["schema"] = view.SchemaName.String
 		viewMap["comment"] = view.Comment.String
+		viewMap["is_secure"] = view.IsSecure
+		viewMap["owner"] = view.Owner.String
+		viewMap["cluster_by"] = view.ClusterBy.String
+		viewMap["rows"] = view.Rows.Int64
+		viewMap["bytes"] = view.Bytes.Int64
+		viewMap["invalid_reason"] = view.InvalidReason.String
 
 		views = append(views, viewMap)
 	}
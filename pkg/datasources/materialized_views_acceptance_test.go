@@ -27,6 +27,9 @@ func TestAcc_MaterializedViews(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.snowflake_materialized_views.v", "materialized_views.#"),
 					resource.TestCheckResourceAttr("data.snowflake_materialized_views.v", "materialized_views.#", "1"),
 					resource.TestCheckResourceAttr("data.snowflake_materialized_views.v", "materialized_views.0.name", viewName),
+					resource.TestCheckResourceAttr("data.snowflake_materialized_views.v", "materialized_views.0.is_secure", "true"),
+					resource.TestCheckResourceAttrSet("data.snowflake_materialized_views.v", "materialized_views.0.owner"),
+					resource.TestCheckResourceAttr("data.snowflake_materialized_views.filtered", "materialized_views.#", "1"),
 				),
 			},
 		},
@@ -75,5 +78,12 @@ func materializedViews(warehouseName string, databaseName string, schemaName str
 		schema = snowflake_materialized_view.v.schema
 		depends_on = [snowflake_materialized_view.v]
 	}
+
+	data snowflake_materialized_views "filtered" {
+		database = snowflake_materialized_view.v.database
+		schema = snowflake_materialized_view.v.schema
+		pattern = snowflake_materialized_view.v.name
+		depends_on = [snowflake_materialized_view.v]
+	}
 	`, warehouseName, databaseName, schemaName, tableName, viewName)
 }
@@ -0,0 +1,111 @@
+package datasources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var networkRulesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the network rules from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the network rules from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"network_rules": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The network rules in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"mode": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func NetworkRules() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadNetworkRules,
+		Schema: networkRulesSchema,
+	}
+}
+
+func ReadNetworkRules(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	pattern := d.Get("pattern").(string)
+
+	currentNetworkRules, err := snowflake.ListNetworkRules(databaseName, schemaName, pattern, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] network rules in schema (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse network rules in schema (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	networkRules := []map[string]interface{}{}
+
+	for _, rule := range currentNetworkRules {
+		ruleMap := map[string]interface{}{}
+
+		ruleMap["name"] = rule.Name.String
+		ruleMap["database"] = rule.DatabaseName.String
+		ruleMap["schema"] = rule.SchemaName.String
+		ruleMap["type"] = rule.Type.String
+		ruleMap["mode"] = rule.Mode.String
+		ruleMap["comment"] = rule.Comment.String
+		ruleMap["owner"] = rule.Owner.String
+
+		networkRules = append(networkRules, ruleMap)
+	}
+
+	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
+	return d.Set("network_rules", networkRules)
+}
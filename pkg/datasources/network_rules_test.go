@@ -0,0 +1,43 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkRulesRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.NetworkRules().Schema, map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"pattern":  "test%",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "owner", "comment", "type", "mode", "entries_in_value_list", "owner_role_type",
+		}).AddRow("", "test_rule", "test_db", "test_schema", "ACCOUNTADMIN", "great comment", "HOST_PORT", "EGRESS", 2, "ROLE")
+		mock.ExpectQuery(`^SHOW NETWORK RULES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+		err := datasources.ReadNetworkRules(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("test_db|test_schema", d.Id())
+	r.Equal([]interface{}{map[string]interface{}{
+		"name":     "test_rule",
+		"database": "test_db",
+		"schema":   "test_schema",
+		"type":     "HOST_PORT",
+		"mode":     "EGRESS",
+		"comment":  "great comment",
+		"owner":    "ACCOUNTADMIN",
+	}}, d.Get("network_rules"))
+}
@@ -0,0 +1,95 @@
+package datasources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var notificationIntegrationsSchema = map[string]*schema.Schema{
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"notification_integrations": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The notification integrations in the account",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"notification_provider": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func NotificationIntegrations() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadNotificationIntegrations,
+		Schema: notificationIntegrationsSchema,
+	}
+}
+
+func ReadNotificationIntegrations(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	pattern := d.Get("pattern").(string)
+
+	currentNotificationIntegrations, err := snowflake.ListNotificationIntegrations(pattern, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] notification integrations in account (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse notification integrations in account (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	notificationIntegrations := []map[string]interface{}{}
+
+	for _, integration := range currentNotificationIntegrations {
+		integrationMap := map[string]interface{}{}
+
+		integrationMap["name"] = integration.Name.String
+		integrationMap["type"] = integration.Type.String
+		integrationMap["enabled"] = integration.Enabled.Bool
+		integrationMap["comment"] = integration.Comment.String
+
+		// The provider is only reported by DESCRIBE NOTIFICATION INTEGRATION
+		provider, err := snowflake.DescribeNotificationIntegrationProvider(integration.Name.String, db)
+		if err != nil {
+			return fmt.Errorf("unable to describe notification integration %v: %w", integration.Name.String, err)
+		}
+		integrationMap["notification_provider"] = provider
+
+		notificationIntegrations = append(notificationIntegrations, integrationMap)
+	}
+
+	d.SetId("notification_integrations")
+	return d.Set("notification_integrations", notificationIntegrations)
+}
@@ -0,0 +1,44 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationIntegrationsRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.NotificationIntegrations().Schema, map[string]interface{}{
+		"pattern": "test%",
+	})
+
+	// the provider is only reported by DESCRIBE NOTIFICATION INTEGRATION
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"name", "type", "category", "enabled", "comment", "created_on",
+		}).AddRow("TEST_NI", "QUEUE - AWS_SNS", "NOTIFICATION", true, "errors topic", "")
+		mock.ExpectQuery(`^SHOW NOTIFICATION INTEGRATIONS LIKE 'test%'$`).WillReturnRows(rows)
+		mock.ExpectQuery(`^DESCRIBE NOTIFICATION INTEGRATION "TEST_NI"$`).WillReturnRows(sqlmock.NewRows([]string{"property", "property_type", "property_value", "property_default"}).
+			AddRow("ENABLED", "Boolean", "true", "false").
+			AddRow("NOTIFICATION_PROVIDER", "String", "AWS_SNS", "").
+			AddRow("DIRECTION", "String", "OUTBOUND", "INBOUND"))
+
+		err := datasources.ReadNotificationIntegrations(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("notification_integrations", d.Id())
+	r.Equal([]interface{}{map[string]interface{}{
+		"name":                  "TEST_NI",
+		"type":                  "QUEUE - AWS_SNS",
+		"enabled":               true,
+		"comment":               "errors topic",
+		"notification_provider": "AWS_SNS",
+	}}, d.Get("notification_integrations"))
+}
@@ -0,0 +1,101 @@
+package datasources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var passwordPoliciesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the password policies from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the password policies from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"password_policies": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The password policies in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func PasswordPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadPasswordPolicies,
+		Schema: passwordPoliciesSchema,
+	}
+}
+
+func ReadPasswordPolicies(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	pattern := d.Get("pattern").(string)
+
+	currentPasswordPolicies, err := snowflake.ListPasswordPolicies(databaseName, schemaName, pattern, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] password policies in schema (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse password policies in schema (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	passwordPolicies := []map[string]interface{}{}
+
+	for _, policy := range currentPasswordPolicies {
+		policyMap := map[string]interface{}{}
+
+		policyMap["name"] = policy.Name.String
+		policyMap["database"] = policy.DatabaseName.String
+		policyMap["schema"] = policy.SchemaName.String
+		policyMap["owner"] = policy.Owner.String
+		policyMap["comment"] = policy.Comment.String
+
+		passwordPolicies = append(passwordPolicies, policyMap)
+	}
+
+	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
+	return d.Set("password_policies", passwordPolicies)
+}
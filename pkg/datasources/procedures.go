@@ -23,6 +23,11 @@ var proceduresSchema = map[string]*schema.Schema{
 		Required:    true,
 		Description: "The schema from which to return the procedures from.",
 	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
 	"procedures": {
 		Type:        schema.TypeList,
 		Computed:    true,
@@ -46,6 +51,15 @@ var proceduresSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"language": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"arguments": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The signature of the procedure as reported by Snowflake, e.g. `ADD(NUMBER, NUMBER) RETURN NUMBER`.",
+				},
 				"argument_types": {
 					Type:     schema.TypeList,
 					Elem:     &schema.Schema{Type: schema.TypeString},
@@ -57,6 +71,10 @@ var proceduresSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"execute_as": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 			},
 		},
 	},
@@ -73,8 +91,9 @@ func ReadProcedures(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
+	pattern := d.Get("pattern").(string)
 
-	currentProcedures, err := snowflake.ListProcedures(databaseName, schemaName, db)
+	currentProcedures, err := snowflake.ListProcedures(databaseName, schemaName, pattern, db)
 	if errors.Is(err, sql.ErrNoRows) {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] procedures in schema (%s) not found", d.Id())
@@ -96,12 +115,23 @@ func ReadProcedures(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
+		argumentTypes := procedureSignatureMap["argumentTypes"].([]string)
+		// System procedures such as ASSOCIATE_SEMANTIC_CATEGORY_TAGS show up in every schema but
+		// cannot always be described, so a failure only leaves language and execute_as empty.
+		properties, err := describeProcedure(db, procedure.DatabaseName.String, procedure.SchemaName.String, procedure.Name.String, argumentTypes)
+		if err != nil {
+			log.Printf("[DEBUG] unable to describe procedure %v: %v", procedure.Name.String, err)
+		}
+
 		procedureMap["name"] = procedure.Name.String
 		procedureMap["database"] = procedure.DatabaseName.String
 		procedureMap["schema"] = procedure.SchemaName.String
 		procedureMap["comment"] = procedure.Comment.String
-		procedureMap["argument_types"] = procedureSignatureMap["argumentTypes"].([]string)
+		procedureMap["language"] = properties["language"]
+		procedureMap["arguments"] = procedure.Arguments.String
+		procedureMap["argument_types"] = argumentTypes
 		procedureMap["return_type"] = procedureSignatureMap["returnType"].(string)
+		procedureMap["execute_as"] = properties["execute as"]
 
 		procedures = append(procedures, procedureMap)
 	}
@@ -110,6 +140,29 @@ func ReadProcedures(d *schema.ResourceData, meta interface{}) error {
 	return d.Set("procedures", procedures)
 }
 
+// describeProcedure returns the properties of a procedure keyed by name. SHOW PROCEDURES does not
+// report the language or owner's/caller's rights, so they have to be read one procedure at a time.
+func describeProcedure(db *sql.DB, databaseName, schemaName, name string, argumentTypes []string) (map[string]string, error) {
+	stmt, err := snowflake.NewProcedureBuilder(databaseName, schemaName, name, argumentTypes).Describe()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := snowflake.Query(db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	descPropValues, err := snowflake.ScanProcedureDescription(rows)
+	if err != nil {
+		return nil, err
+	}
+	properties := make(map[string]string, len(descPropValues))
+	for _, desc := range descPropValues {
+		properties[desc.Property.String] = desc.Value.String
+	}
+	return properties, nil
+}
+
 func parseArguments(arguments string) (map[string]interface{}, error) {
 	r := regexp.MustCompile(`(?P<callable_name>[^(]+)\((?P<argument_signature>[^)]*)\) RETURN (?P<return_type>.*)`)
 	matches := r.FindStringSubmatch(arguments)
@@ -26,6 +26,10 @@ func TestAcc_Procedures(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.snowflake_procedures.t", "procedures.#"),
 					// resource.TestCheckResourceAttr("data.snowflake_procedures.t", "procedures.#", "3"),
 					// Extra 1 in procedure count above due to ASSOCIATE_SEMANTIC_CATEGORY_TAGS appearing in all "SHOW PROCEDURES IN ..." commands
+					resource.TestCheckResourceAttr("data.snowflake_procedures.filtered", "procedures.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_procedures.filtered", "procedures.0.name", procedureWithArgumentsName),
+					resource.TestCheckResourceAttr("data.snowflake_procedures.filtered", "procedures.0.language", "JAVASCRIPT"),
+					resource.TestCheckResourceAttr("data.snowflake_procedures.filtered", "procedures.0.execute_as", "OWNER"),
 				),
 			},
 		},
@@ -78,6 +82,12 @@ data snowflake_procedures "t" {
 	schema = snowflake_schema.test_schema.name
 	depends_on = [snowflake_procedure.test_proc_simple, snowflake_procedure.test_proc]
 }
+
+data snowflake_procedures "filtered" {
+	database = snowflake_database.test_database.name
+	schema = snowflake_schema.test_schema.name
+	pattern = snowflake_procedure.test_proc.name
+}
 `
 	return fmt.Sprintf(s, databaseName, schemaName, procedureName, procedureWithArgumentsName)
 }
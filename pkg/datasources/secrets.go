@@ -0,0 +1,106 @@
+package datasources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var secretsSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the secrets from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the secrets from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"secrets": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The secrets in the schema. Only metadata is returned, never the secret values.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"secret_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func Secrets() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadSecrets,
+		Schema: secretsSchema,
+	}
+}
+
+func ReadSecrets(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	pattern := d.Get("pattern").(string)
+
+	currentSecrets, err := snowflake.ListSecrets(databaseName, schemaName, pattern, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] secrets in schema (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse secrets in schema (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	secrets := []map[string]interface{}{}
+
+	for _, secret := range currentSecrets {
+		secretMap := map[string]interface{}{}
+
+		secretMap["name"] = secret.Name.String
+		secretMap["database"] = secret.DatabaseName.String
+		secretMap["schema"] = secret.SchemaName.String
+		secretMap["secret_type"] = secret.SecretType.String
+		secretMap["owner"] = secret.Owner.String
+		secretMap["comment"] = secret.Comment.String
+
+		secrets = append(secrets, secretMap)
+	}
+
+	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
+	return d.Set("secrets", secrets)
+}
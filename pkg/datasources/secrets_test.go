@@ -0,0 +1,42 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretsRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Secrets().Schema, map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"pattern":  "test%",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "schema_name", "database_name", "owner", "comment", "secret_type", "oauth_scopes", "owner_role_type",
+		}).AddRow("", "test_secret", "test_schema", "test_db", "ACCOUNTADMIN", "great comment", "PASSWORD", "", "ROLE")
+		mock.ExpectQuery(`^SHOW SECRETS LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+		err := datasources.ReadSecrets(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("test_db|test_schema", d.Id())
+	r.Equal([]interface{}{map[string]interface{}{
+		"name":        "test_secret",
+		"database":    "test_db",
+		"schema":      "test_schema",
+		"secret_type": "PASSWORD",
+		"owner":       "ACCOUNTADMIN",
+		"comment":     "great comment",
+	}}, d.Get("secrets"))
+}
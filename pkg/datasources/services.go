@@ -0,0 +1,126 @@
+package datasources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var servicesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the services from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the services from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"services": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The services in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"compute_pool": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"dns_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"current_instances": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"target_instances": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func Services() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadServices,
+		Schema: servicesSchema,
+	}
+}
+
+func ReadServices(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	pattern := d.Get("pattern").(string)
+
+	currentServices, err := snowflake.ListServices(databaseName, schemaName, pattern, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] services in schema (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse services in schema (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	services := []map[string]interface{}{}
+
+	for _, service := range currentServices {
+		serviceMap := map[string]interface{}{}
+
+		serviceMap["name"] = service.Name.String
+		serviceMap["database"] = service.DatabaseName.String
+		serviceMap["schema"] = service.SchemaName.String
+		serviceMap["compute_pool"] = service.ComputePool.String
+		serviceMap["dns_name"] = service.DNSName.String
+		serviceMap["current_instances"] = service.CurrentInstances.Int64
+		serviceMap["target_instances"] = service.TargetInstances.Int64
+		serviceMap["status"] = service.Status.String
+		serviceMap["owner"] = service.Owner.String
+		serviceMap["comment"] = service.Comment.String
+
+		services = append(services, serviceMap)
+	}
+
+	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
+	return d.Set("services", services)
+}
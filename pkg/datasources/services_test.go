@@ -0,0 +1,46 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicesRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Services().Schema, map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"pattern":  "test%",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "owner", "compute_pool", "dns_name", "current_instances", "target_instances", "status", "comment",
+		}).AddRow("", "test_service", "test_db", "test_schema", "ACCOUNTADMIN", "TEST_POOL", "test-service.test-schema.test-db.snowflakecomputing.internal", 1, 2, "RUNNING", "great comment")
+		mock.ExpectQuery(`^SHOW SERVICES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+		err := datasources.ReadServices(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("test_db|test_schema", d.Id())
+	r.Equal([]interface{}{map[string]interface{}{
+		"name":              "test_service",
+		"database":          "test_db",
+		"schema":            "test_schema",
+		"compute_pool":      "TEST_POOL",
+		"dns_name":          "test-service.test-schema.test-db.snowflakecomputing.internal",
+		"current_instances": 1,
+		"target_instances":  2,
+		"status":            "RUNNING",
+		"owner":             "ACCOUNTADMIN",
+		"comment":           "great comment",
+	}}, d.Get("services"))
+}
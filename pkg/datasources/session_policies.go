@@ -0,0 +1,113 @@
+package datasources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var sessionPoliciesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the session policies from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the session policies from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"session_policies": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The session policies in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"session_idle_timeout_mins": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of minutes a session can be idle before it times out.",
+				},
+			},
+		},
+	},
+}
+
+func SessionPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadSessionPolicies,
+		Schema: sessionPoliciesSchema,
+	}
+}
+
+func ReadSessionPolicies(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	pattern := d.Get("pattern").(string)
+
+	currentSessionPolicies, err := snowflake.ListSessionPolicies(databaseName, schemaName, pattern, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] session policies in schema (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse session policies in schema (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	sessionPolicies := []map[string]interface{}{}
+
+	for _, policy := range currentSessionPolicies {
+		policyMap := map[string]interface{}{}
+
+		policyMap["name"] = policy.Name.String
+		policyMap["database"] = policy.DatabaseName.String
+		policyMap["schema"] = policy.SchemaName.String
+		policyMap["owner"] = policy.Owner.String
+		policyMap["comment"] = policy.Comment.String
+
+		// The idle timeout is only reported by DESCRIBE SESSION POLICY
+		timeout, err := snowflake.DescribeSessionPolicyIdleTimeout(databaseName, schemaName, policy.Name.String, db)
+		if err != nil {
+			return fmt.Errorf("unable to describe session policy %v: %w", policy.Name.String, err)
+		}
+		policyMap["session_idle_timeout_mins"] = timeout
+
+		sessionPolicies = append(sessionPolicies, policyMap)
+	}
+
+	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
+	return d.Set("session_policies", sessionPolicies)
+}
@@ -0,0 +1,44 @@
+package datasources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionPoliciesRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.SessionPolicies().Schema, map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"pattern":  "test%",
+	})
+
+	// the idle timeout is only reported by DESCRIBE SESSION POLICY
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "kind", "owner", "comment", "options",
+		}).AddRow("", "test_policy", "test_db", "test_schema", "SESSION_POLICY", "ACCOUNTADMIN", "great comment", "")
+		mock.ExpectQuery(`^SHOW SESSION POLICIES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+		mock.ExpectQuery(`^DESCRIBE SESSION POLICY "test_db"."test_schema"."test_policy"$`).WillReturnRows(sqlmock.NewRows([]string{"created_on", "name", "session_idle_timeout_mins", "session_ui_idle_timeout_mins", "comment"}).AddRow("", "test_policy", 60, 240, "great comment"))
+
+		err := datasources.ReadSessionPolicies(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("test_db|test_schema", d.Id())
+	r.Equal([]interface{}{map[string]interface{}{
+		"name":                      "test_policy",
+		"database":                  "test_db",
+		"schema":                    "test_schema",
+		"owner":                     "ACCOUNTADMIN",
+		"comment":                   "great comment",
+		"session_idle_timeout_mins": 60,
+	}}, d.Get("session_policies"))
+}
@@ -11,6 +11,11 @@ import (
 )
 
 var storageIntegrationsSchema = map[string]*schema.Schema{
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
 	"storage_integrations": {
 		Type:        schema.TypeList,
 		Computed:    true,
@@ -35,6 +40,22 @@ var storageIntegrationsSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"storage_provider": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"storage_allowed_locations": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Computed:    true,
+					Description: "The locations external stages using the integration can reference.",
+				},
+				"storage_blocked_locations": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Computed:    true,
+					Description: "The locations external stages using the integration cannot reference.",
+				},
 			},
 		},
 	},
@@ -49,6 +70,7 @@ func StorageIntegrations() *schema.Resource {
 
 func ReadStorageIntegrations(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
+	pattern := d.Get("pattern").(string)
 
 	account, err := snowflake.ReadCurrentAccount(db)
 	if err != nil {
@@ -59,7 +81,7 @@ func ReadStorageIntegrations(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(fmt.Sprintf("%s.%s", account.Account, account.Region))
 
-	currentStorageIntegrations, err := snowflake.ListStorageIntegrations(db)
+	currentStorageIntegrations, err := snowflake.ListStorageIntegrations(pattern, db)
 	if errors.Is(err, sql.ErrNoRows) {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] no storage integrations found in account (%s)", d.Id())
@@ -81,6 +103,15 @@ func ReadStorageIntegrations(d *schema.ResourceData, meta interface{}) error {
 		storageIntegrationMap["comment"] = storageIntegration.Comment.String
 		storageIntegrationMap["enabled"] = storageIntegration.Enabled.Bool
 
+		// The provider and locations are only reported by DESCRIBE INTEGRATION
+		locations, err := snowflake.DescribeStorageIntegrationLocations(storageIntegration.Name.String, db)
+		if err != nil {
+			return fmt.Errorf("unable to describe storage integration %v: %w", storageIntegration.Name.String, err)
+		}
+		storageIntegrationMap["storage_provider"] = locations.StorageProvider
+		storageIntegrationMap["storage_allowed_locations"] = locations.StorageAllowedLocations
+		storageIntegrationMap["storage_blocked_locations"] = locations.StorageBlockedLocations
+
 		storageIntegrations = append(storageIntegrations, storageIntegrationMap)
 	}
 
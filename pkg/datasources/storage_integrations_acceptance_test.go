@@ -20,6 +20,11 @@ func TestAcc_StorageIntegrations(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.snowflake_storage_integrations.s", "storage_integrations.#"),
 					resource.TestCheckResourceAttrSet("data.snowflake_storage_integrations.s", "storage_integrations.0.name"),
+					resource.TestCheckResourceAttr("data.snowflake_storage_integrations.filtered", "storage_integrations.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_storage_integrations.filtered", "storage_integrations.0.name", storageIntegrationName),
+					resource.TestCheckResourceAttr("data.snowflake_storage_integrations.filtered", "storage_integrations.0.storage_provider", "S3"),
+					resource.TestCheckResourceAttr("data.snowflake_storage_integrations.filtered", "storage_integrations.0.storage_allowed_locations.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_storage_integrations.filtered", "storage_integrations.0.storage_allowed_locations.0", "s3://foo/"),
 				),
 			},
 		},
@@ -30,7 +35,7 @@ func storageIntegrations(storageIntegrationName string) string {
 	return fmt.Sprintf(`
 	
 	resource snowflake_storage_integration i {
-		name = "%v"
+		name = "%[1]v"
 		storage_allowed_locations = ["s3://foo/"]
 		storage_provider = "S3"
 		storage_aws_role_arn = "arn:aws:iam::000000000001:/role/test"
@@ -39,5 +44,10 @@ func storageIntegrations(storageIntegrationName string) string {
 	data snowflake_storage_integrations "s" {
 		depends_on = [snowflake_storage_integration.i]
 	}
+
+	data snowflake_storage_integrations "filtered" {
+		pattern    = "%[1]v"
+		depends_on = [snowflake_storage_integration.i]
+	}
 	`, storageIntegrationName)
 }
@@ -0,0 +1,110 @@
+package datasources
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var streamGrantsSchema = map[string]*schema.Schema{
+	"database_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database of the stream.",
+	},
+	"schema_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema of the stream.",
+	},
+	"stream_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The name of the stream whose grants are listed. When unset, the future grants on streams in the schema are listed instead.",
+	},
+	"grants": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The grants on the stream, or the future grants on streams in the schema.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"privilege": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The privilege granted.",
+				},
+				"grantee_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the role or share the privilege is granted to.",
+				},
+				"granted_to": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of the grantee, e.g. ROLE or SHARE.",
+				},
+				"with_grant_option": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the grantee can grant the privilege to others.",
+				},
+			},
+		},
+	},
+}
+
+// StreamGrants Snowflake stream grants data source.
+func StreamGrants() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadStreamGrants,
+		Schema: streamGrantsSchema,
+	}
+}
+
+// ReadStreamGrants lists the grants on a stream, or the future grants on streams in a schema.
+func ReadStreamGrants(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database_name").(string)
+	schemaName := d.Get("schema_name").(string)
+	streamName := d.Get("stream_name").(string)
+
+	// the same builders as snowflake_stream_grant, so that the listed grants are the ones it reads
+	var builder snowflake.GrantBuilder
+	if streamName != "" {
+		builder = snowflake.StreamGrant(databaseName, schemaName, streamName)
+	} else {
+		builder = snowflake.FutureStreamGrant(databaseName, schemaName)
+	}
+
+	grantDetails, err := snowflake.ShowGrants(db, builder)
+	if err != nil {
+		return fmt.Errorf("unable to show grants on stream %v.%v.%v: %w", databaseName, schemaName, streamName, err)
+	}
+
+	grants := []map[string]interface{}{}
+	for _, grant := range grantDetails {
+		grantedOn, grantedTo := grant.GrantedOn.String, grant.GrantedTo.String
+		if grantedOn == "" {
+			grantedOn = grant.GrantOn.String
+		}
+		if grantedTo == "" {
+			grantedTo = grant.GrantTo.String
+		}
+		// future grants in a schema cover every object type
+		if grantedOn != builder.GrantType() {
+			continue
+		}
+		grants = append(grants, map[string]interface{}{
+			"privilege":         grant.Privilege.String,
+			"grantee_name":      grant.GranteeName.String,
+			"granted_to":        grantedTo,
+			"with_grant_option": strings.EqualFold(grant.GrantOption.String, "true"),
+		})
+	}
+
+	d.SetId(fmt.Sprintf(`%v|%v|%v`, databaseName, schemaName, streamName))
+	return d.Set("grants", grants)
+}
@@ -0,0 +1,97 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_StreamGrants(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schemaName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	streamName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	tableName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	roleName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: streamGrants(databaseName, schemaName, tableName, streamName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_stream_grants.t", "database_name", databaseName),
+					resource.TestCheckResourceAttr("data.snowflake_stream_grants.t", "stream_name", streamName),
+					resource.TestCheckTypeSetElemNestedAttrs("data.snowflake_stream_grants.t", "grants.*", map[string]string{
+						"privilege":         "SELECT",
+						"grantee_name":      roleName,
+						"granted_to":        "ROLE",
+						"with_grant_option": "false",
+					}),
+					resource.TestCheckResourceAttr("data.snowflake_stream_grants.future", "grants.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func streamGrants(databaseName string, schemaName string, tableName string, streamName string, roleName string) string {
+	return fmt.Sprintf(`
+
+	resource snowflake_database "test_database" {
+		name = "%v"
+	}
+
+	resource snowflake_schema "test_schema" {
+		name 	 = "%v"
+		database = snowflake_database.test_database.name
+	}
+
+	resource snowflake_table "test_stream_on_table" {
+		database 	    = snowflake_database.test_database.name
+		schema   	    = snowflake_schema.test_schema.name
+		change_tracking = true
+		name     	    = "%v"
+		comment  	    = "Terraform acceptance test"
+		column {
+			name = "column1"
+			type = "VARIANT"
+		}
+	}
+
+	resource snowflake_stream "test_stream" {
+		database = snowflake_database.test_database.name
+		schema   = snowflake_schema.test_schema.name
+		name     = "%v"
+		comment  = "Terraform acceptance test"
+		on_table = "${snowflake_database.test_database.name}.${snowflake_schema.test_schema.name}.${snowflake_table.test_stream_on_table.name}"
+	}
+
+	resource snowflake_role "test_role" {
+		name = "%v"
+	}
+
+	resource snowflake_stream_grant "test_grant" {
+		database_name = snowflake_stream.test_stream.database
+		schema_name   = snowflake_stream.test_stream.schema
+		stream_name   = snowflake_stream.test_stream.name
+		privilege     = "SELECT"
+		roles         = [snowflake_role.test_role.name]
+	}
+
+	data snowflake_stream_grants "t" {
+		database_name = snowflake_stream.test_stream.database
+		schema_name   = snowflake_stream.test_stream.schema
+		stream_name   = snowflake_stream.test_stream.name
+		depends_on    = [snowflake_stream_grant.test_grant]
+	}
+
+	data snowflake_stream_grants "future" {
+		database_name = snowflake_stream.test_stream.database
+		schema_name   = snowflake_stream.test_stream.schema
+		depends_on    = [snowflake_stream_grant.test_grant]
+	}
+	`, databaseName, schemaName, tableName, streamName, roleName)
+}
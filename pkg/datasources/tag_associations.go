@@ -0,0 +1,106 @@
+package datasources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var tagAssociationsSchema = map[string]*schema.Schema{
+	"tag_name": {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The fully qualified name of the tag, e.g. `\"db\".\"schema\".\"tag\"`.",
+		ValidateFunc: snowflakeValidation.ValidateFullyQualifiedObjectID,
+	},
+	"object_types": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Only return the associations on objects of these types (e.g. TABLE, COLUMN).",
+	},
+	"tag_associations": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The objects the tag is set on. These are read from SNOWFLAKE.ACCOUNT_USAGE.TAG_REFERENCES, which can lag behind by up to two hours.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"object_schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"object_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"object_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"column_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tag_value": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"object_id": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func TagAssociations() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadTagAssociations,
+		Schema: tagAssociationsSchema,
+	}
+}
+
+func ReadTagAssociations(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	tagName := d.Get("tag_name").(string)
+	objectTypes := []string{}
+	for _, objectType := range d.Get("object_types").([]interface{}) {
+		objectTypes = append(objectTypes, objectType.(string))
+	}
+
+	builder := snowflake.NewTagAssociationBuilder(tagName)
+	references, err := snowflake.ListTagReferences(builder, objectTypes, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Printf("[DEBUG] tag associations for tag (%s) not found", tagName)
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("unable to list associations of tag %v: %w", tagName, err)
+	}
+
+	tagAssociations := []map[string]interface{}{}
+	for _, reference := range references {
+		tagAssociations = append(tagAssociations, map[string]interface{}{
+			"object_database": reference.ObjectDatabase.String,
+			"object_schema":   reference.ObjectSchema.String,
+			"object_name":     reference.ObjectName.String,
+			"object_type":     reference.Domain.String,
+			"column_name":     reference.ColumnName.String,
+			"tag_value":       reference.TagValue.String,
+			"object_id":       reference.ObjectID.Int64,
+		})
+	}
+
+	d.SetId(tagName)
+	return d.Set("tag_associations", tagAssociations)
+}
@@ -0,0 +1,61 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_TagAssociations(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: tagAssociations(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_tag_associations.t", "tag_name", fmt.Sprintf("%[1]v|%[1]v|%[1]v", name)),
+					resource.TestCheckResourceAttr("data.snowflake_tag_associations.t", "object_types.#", "1"),
+					resource.TestCheckResourceAttrSet("data.snowflake_tag_associations.t", "tag_associations.#"),
+				),
+			},
+		},
+	})
+}
+
+func tagAssociations(name string) string {
+	return fmt.Sprintf(`
+	resource snowflake_database "d" {
+		name = "%[1]v"
+	}
+
+	resource snowflake_schema "s" {
+		name     = "%[1]v"
+		database = snowflake_database.d.name
+	}
+
+	resource snowflake_tag "t" {
+		name     = "%[1]v"
+		database = snowflake_schema.s.database
+		schema   = snowflake_schema.s.name
+	}
+
+	resource snowflake_tag_association "a" {
+		object_identifier {
+			name = snowflake_database.d.name
+		}
+		object_type = "DATABASE"
+		tag_id      = snowflake_tag.t.id
+		tag_value   = "finance"
+	}
+
+	data snowflake_tag_associations "t" {
+		tag_name     = snowflake_tag_association.a.tag_id
+		object_types = ["DATABASE"]
+	}
+	`, name)
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"log"
 	"regexp"
@@ -12,6 +13,13 @@ import (
 )
 
 func init() {
+	sql.Register("snowflake-instrumented", instrument(&gosnowflake.SnowflakeDriver{}, false))
+	sql.Register("snowflake-instrumented-args", instrument(&gosnowflake.SnowflakeDriver{}, true))
+}
+
+// instrument returns d wrapped so that the operations run through it are logged at DEBUG level.
+// The arguments of statements are only logged when logArgs is true.
+func instrument(d driver.Driver, logArgs bool) driver.Driver {
 	re := regexp.MustCompile(`\r?\n`)
 
 	logger := instrumentedsql.LoggerFunc(func(ctx context.Context, msg string, keyvals ...interface{}) {
@@ -19,9 +27,18 @@ func init() {
 		log.Println(re.ReplaceAllString(s, " "))
 	})
 
-	sql.Register("snowflake-instrumented", instrumentedsql.WrapDriver(&gosnowflake.SnowflakeDriver{}, instrumentedsql.WithLogger(logger)))
+	opts := []instrumentedsql.Opt{instrumentedsql.WithLogger(logger)}
+	if !logArgs {
+		opts = append(opts, instrumentedsql.WithOmitArgs())
+	}
+	return instrumentedsql.WrapDriver(d, opts...)
 }
 
-func Open(dsn string) (*sql.DB, error) {
+// Open opens a connection pool to Snowflake whose operations are logged at DEBUG level. When
+// logStatements is true, the arguments of the statements sent to Snowflake are logged as well.
+func Open(dsn string, logStatements bool) (*sql.DB, error) {
+	if logStatements {
+		return sql.Open("snowflake-instrumented-args", dsn)
+	}
 	return sql.Open("snowflake-instrumented", dsn)
 }
@@ -0,0 +1,70 @@
+package db
+
+import (
+	"bytes"
+	"database/sql"
+	"log"
+	"os"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	mockDB, _, err := sqlmock.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	// the drivers are registered once, registering one twice panics with go test -count=2
+	sql.Register("sqlmock-instrumented", instrument(mockDB.Driver(), false))
+	sql.Register("sqlmock-instrumented-args", instrument(mockDB.Driver(), true))
+	mockDB.Close()
+	os.Exit(m.Run())
+}
+
+// logStatements runs a statement with an argument and a query through the driver registered as
+// driverName and returns what was logged.
+func logStatements(t *testing.T, driverName string, dsn string) string {
+	t.Helper()
+	r := require.New(t)
+
+	mockDB, mock, err := sqlmock.NewWithDSN(dsn)
+	r.NoError(err)
+	defer mockDB.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	db, err := sql.Open(driverName, dsn)
+	r.NoError(err)
+	defer db.Close()
+
+	mock.ExpectExec(`^CREATE DATABASE IDENTIFIER\(\?\)$`).WithArgs("TEST").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`^SHOW DATABASES LIKE 'TEST'$`).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("TEST"))
+
+	_, err = db.Exec(`CREATE DATABASE IDENTIFIER(?)`, "TEST")
+	r.NoError(err)
+	var name string
+	r.NoError(db.QueryRow(`SHOW DATABASES LIKE 'TEST'`).Scan(&name))
+	r.NoError(mock.ExpectationsWereMet())
+	return buf.String()
+}
+
+func TestStatementLogging(t *testing.T) {
+	r := require.New(t)
+
+	logged := logStatements(t, "sqlmock-instrumented", "statement-logging")
+	r.Contains(logged, `[DEBUG] sql-conn-exec [query CREATE DATABASE IDENTIFIER(?) err <nil>`)
+	r.Contains(logged, `[DEBUG] sql-conn-query [query SHOW DATABASES LIKE 'TEST' err <nil>`)
+	r.NotContains(logged, "args")
+}
+
+func TestStatementLoggingWithArgs(t *testing.T) {
+	r := require.New(t)
+
+	logged := logStatements(t, "sqlmock-instrumented-args", "statement-logging-args")
+	r.Contains(logged, `[DEBUG] sql-conn-exec [query CREATE DATABASE IDENTIFIER(?) err <nil>`)
+	r.Contains(logged, `args {[string "TEST"]}`)
+}
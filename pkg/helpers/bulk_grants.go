@@ -0,0 +1,39 @@
+package helpers
+
+import "strings"
+
+// bulkGrantSupport records, per object type, whether Snowflake accepts GRANT ... ON FUTURE <type>S
+// and GRANT ... ON ALL <type>S. Types missing from the matrix support neither.
+var bulkGrantSupport = map[string]struct{ future, all bool }{
+	"ALERT":             {future: true, all: true},
+	"EXTERNAL TABLE":    {future: true, all: true},
+	"FILE FORMAT":       {future: true, all: true},
+	"FUNCTION":          {future: true, all: true},
+	"MATERIALIZED VIEW": {future: true, all: true},
+	"PIPE":              {future: true, all: true},
+	"PROCEDURE":         {future: true, all: true},
+	"SCHEMA":            {future: true, all: true},
+	"SEQUENCE":          {future: true, all: true},
+	"STAGE":             {future: true, all: true},
+	"STREAM":            {future: true, all: true},
+	"TABLE":             {future: true, all: true},
+	"TASK":              {future: true, all: true},
+	"VIEW":              {future: true, all: true},
+	"MASKING POLICY":    {future: false, all: false},
+	"ROW ACCESS POLICY": {future: false, all: false},
+	"TAG":               {future: false, all: false},
+}
+
+// SupportsFutureGrants reports whether privileges can be granted on future objects of objectType.
+func SupportsFutureGrants(objectType string) bool {
+	return bulkGrantSupport[normalizeObjectType(objectType)].future
+}
+
+// SupportsAllGrants reports whether privileges can be granted on all existing objects of objectType.
+func SupportsAllGrants(objectType string) bool {
+	return bulkGrantSupport[normalizeObjectType(objectType)].all
+}
+
+func normalizeObjectType(objectType string) string {
+	return strings.ReplaceAll(strings.ToUpper(strings.TrimSpace(objectType)), "_", " ")
+}
@@ -13,8 +13,10 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/snowflakedb/gosnowflake"
 	"github.com/youmark/pkcs8"
@@ -170,6 +172,45 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_WAREHOUSE", nil),
 			},
+			"client_request_mfa_token": {
+				Type:        schema.TypeBool,
+				Description: "When true, password authentication uses the `username_password_mfa` authenticator and the Snowflake driver is asked to cache the MFA token (`CLIENT_REQUEST_MFA_TOKEN=true` in the connection string) so that the connections opened during a run do not each prompt for MFA. Has no effect with other authentication methods. Can be sourced from the `SNOWFLAKE_CLIENT_REQUEST_MFA_TOKEN` environment variable.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_CLIENT_REQUEST_MFA_TOKEN", false),
+			},
+			"log_statements": {
+				Type:        schema.TypeBool,
+				Description: "The statements the provider sends to Snowflake are always logged at DEBUG level, e.g. with `TF_LOG=DEBUG`. When true, their arguments are logged as well. Can be sourced from the `SNOWFLAKE_LOG_STATEMENTS` environment variable.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_LOG_STATEMENTS", false),
+			},
+			"serialize_grants_per_object": {
+				Type:        schema.TypeBool,
+				Description: "When true, grant resources on the same object grant and revoke one at a time instead of in parallel, which avoids transient lock failures when many grant resources target one object. Can be sourced from the `SNOWFLAKE_SERIALIZE_GRANTS_PER_OBJECT` environment variable.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_SERIALIZE_GRANTS_PER_OBJECT", false),
+			},
+			"max_open_connections": {
+				Type:         schema.TypeInt,
+				Description:  "The maximum number of open connections to Snowflake. Defaults to 0, which means no limit. Can be sourced from the `SNOWFLAKE_MAX_OPEN_CONNECTIONS` environment variable.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_MAX_OPEN_CONNECTIONS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_idle_connections": {
+				Type:         schema.TypeInt,
+				Description:  "The maximum number of idle connections kept open to Snowflake. Defaults to 0, which keeps the Go default of 2. Can be sourced from the `SNOWFLAKE_MAX_IDLE_CONNECTIONS` environment variable.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_MAX_IDLE_CONNECTIONS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"connection_max_lifetime_seconds": {
+				Type:         schema.TypeInt,
+				Description:  "The maximum number of seconds a connection to Snowflake may be reused. Defaults to 0, which means connections are reused forever. Can be sourced from the `SNOWFLAKE_CONNECTION_MAX_LIFETIME_SECONDS` environment variable.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_CONNECTION_MAX_LIFETIME_SECONDS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 		ResourcesMap:   getResources(),
 		DataSourcesMap: getDataSources(),
@@ -180,6 +221,7 @@ func Provider() *schema.Provider {
 func GetGrantResources() resources.TerraformGrantResources {
 	grants := resources.TerraformGrantResources{
 		"snowflake_account_grant":           resources.AccountGrant(),
+		"snowflake_alert_grant":             resources.AlertGrant(),
 		"snowflake_database_grant":          resources.DatabaseGrant(),
 		"snowflake_external_table_grant":    resources.ExternalTableGrant(),
 		"snowflake_file_format_grant":       resources.FileFormatGrant(),
@@ -208,51 +250,60 @@ func GetGrantResources() resources.TerraformGrantResources {
 func getResources() map[string]*schema.Resource {
 	// NOTE(): do not add grant resources here
 	others := map[string]*schema.Resource{
-		"snowflake_account":                        resources.Account(),
-		"snowflake_account_parameter":              resources.AccountParameter(),
-		"snowflake_api_integration":                resources.APIIntegration(),
-		"snowflake_database":                       resources.Database(),
-		"snowflake_external_function":              resources.ExternalFunction(),
-		"snowflake_failover_group":                 resources.FailoverGroup(),
-		"snowflake_file_format":                    resources.FileFormat(),
-		"snowflake_function":                       resources.Function(),
-		"snowflake_managed_account":                resources.ManagedAccount(),
-		"snowflake_masking_policy":                 resources.MaskingPolicy(),
-		"snowflake_materialized_view":              resources.MaterializedView(),
-		"snowflake_network_policy_attachment":      resources.NetworkPolicyAttachment(),
-		"snowflake_network_policy":                 resources.NetworkPolicy(),
-		"snowflake_oauth_integration":              resources.OAuthIntegration(),
-		"snowflake_object_parameter":               resources.ObjectParameter(),
-		"snowflake_external_oauth_integration":     resources.ExternalOauthIntegration(),
-		"snowflake_pipe":                           resources.Pipe(),
-		"snowflake_procedure":                      resources.Procedure(),
-		"snowflake_resource_monitor":               resources.ResourceMonitor(),
-		"snowflake_role":                           resources.Role(),
-		"snowflake_role_grants":                    resources.RoleGrants(),
-		"snowflake_role_ownership_grant":           resources.RoleOwnershipGrant(),
-		"snowflake_row_access_policy":              resources.RowAccessPolicy(),
-		"snowflake_saml_integration":               resources.SAMLIntegration(),
-		"snowflake_schema":                         resources.Schema(),
-		"snowflake_scim_integration":               resources.SCIMIntegration(),
-		"snowflake_sequence":                       resources.Sequence(),
-		"snowflake_session_parameter":              resources.SessionParameter(),
-		"snowflake_share":                          resources.Share(),
-		"snowflake_stage":                          resources.Stage(),
-		"snowflake_storage_integration":            resources.StorageIntegration(),
-		"snowflake_notification_integration":       resources.NotificationIntegration(),
-		"snowflake_stream":                         resources.Stream(),
-		"snowflake_table":                          resources.Table(),
-		"snowflake_table_constraint":               resources.TableConstraint(),
-		"snowflake_external_table":                 resources.ExternalTable(),
-		"snowflake_tag":                            resources.Tag(),
-		"snowflake_tag_association":                resources.TagAssociation(),
-		"snowflake_tag_masking_policy_association": resources.TagMaskingPolicyAssociation(),
-		"snowflake_task":                           resources.Task(),
-		"snowflake_user":                           resources.User(),
-		"snowflake_user_ownership_grant":           resources.UserOwnershipGrant(),
-		"snowflake_user_public_keys":               resources.UserPublicKeys(),
-		"snowflake_view":                           resources.View(),
-		"snowflake_warehouse":                      resources.Warehouse(),
+		"snowflake_account":                                 resources.Account(),
+		"snowflake_account_parameter":                       resources.AccountParameter(),
+		"snowflake_aggregation_policy_attachment":           resources.AggregationPolicyAttachment(),
+		"snowflake_api_integration":                         resources.APIIntegration(),
+		"snowflake_authentication_policy_attachment":        resources.AuthenticationPolicyAttachment(),
+		"snowflake_database":                                resources.Database(),
+		"snowflake_external_function":                       resources.ExternalFunction(),
+		"snowflake_failover_group":                          resources.FailoverGroup(),
+		"snowflake_file_format":                             resources.FileFormat(),
+		"snowflake_function":                                resources.Function(),
+		"snowflake_managed_account":                         resources.ManagedAccount(),
+		"snowflake_masking_policy":                          resources.MaskingPolicy(),
+		"snowflake_materialized_view":                       resources.MaterializedView(),
+		"snowflake_network_policy_attachment":               resources.NetworkPolicyAttachment(),
+		"snowflake_network_policy":                          resources.NetworkPolicy(),
+		"snowflake_oauth_integration":                       resources.OAuthIntegration(),
+		"snowflake_object_grants_exclusive":                 resources.ObjectGrantsExclusive(),
+		"snowflake_object_parameter":                        resources.ObjectParameter(),
+		"snowflake_external_oauth_integration":              resources.ExternalOauthIntegration(),
+		"snowflake_password_policy_attachment":              resources.PasswordPolicyAttachment(),
+		"snowflake_pipe":                                    resources.Pipe(),
+		"snowflake_procedure":                               resources.Procedure(),
+		"snowflake_projection_policy_attachment":            resources.ProjectionPolicyAttachment(),
+		"snowflake_resource_monitor":                        resources.ResourceMonitor(),
+		"snowflake_role":                                    resources.Role(),
+		"snowflake_role_grants":                             resources.RoleGrants(),
+		"snowflake_role_ownership_grant":                    resources.RoleOwnershipGrant(),
+		"snowflake_row_access_policy":                       resources.RowAccessPolicy(),
+		"snowflake_saml_integration":                        resources.SAMLIntegration(),
+		"snowflake_schema":                                  resources.Schema(),
+		"snowflake_scim_integration":                        resources.SCIMIntegration(),
+		"snowflake_sequence":                                resources.Sequence(),
+		"snowflake_session_parameter":                       resources.SessionParameter(),
+		"snowflake_session_policy_attachment":               resources.SessionPolicyAttachment(),
+		"snowflake_share":                                   resources.Share(),
+		"snowflake_stage":                                   resources.Stage(),
+		"snowflake_storage_integration":                     resources.StorageIntegration(),
+		"snowflake_notification_integration":                resources.NotificationIntegration(),
+		"snowflake_stream":                                  resources.Stream(),
+		"snowflake_table":                                   resources.Table(),
+		"snowflake_table_column_masking_policy_application": resources.TableColumnMaskingPolicyApplication(),
+		"snowflake_table_constraint":                        resources.TableConstraint(),
+		"snowflake_table_row_access_policy_attachment":      resources.TableRowAccessPolicyAttachment(),
+		"snowflake_external_table":                          resources.ExternalTable(),
+		"snowflake_tag":                                     resources.Tag(),
+		"snowflake_tag_association":                         resources.TagAssociation(),
+		"snowflake_tag_masking_policy_association":          resources.TagMaskingPolicyAssociation(),
+		"snowflake_task":                                    resources.Task(),
+		"snowflake_user":                                    resources.User(),
+		"snowflake_user_ownership_grant":                    resources.UserOwnershipGrant(),
+		"snowflake_user_public_keys":                        resources.UserPublicKeys(),
+		"snowflake_view":                                    resources.View(),
+		"snowflake_view_row_access_policy_attachment":       resources.ViewRowAccessPolicyAttachment(),
+		"snowflake_warehouse":                               resources.Warehouse(),
 	}
 
 	return mergeSchemas(
@@ -265,6 +316,7 @@ func getDataSources() map[string]*schema.Resource {
 	dataSources := map[string]*schema.Resource{
 		"snowflake_current_account":                    datasources.CurrentAccount(),
 		"snowflake_current_role":                       datasources.CurrentRole(),
+		"snowflake_effective_grant":                    datasources.EffectiveGrant(),
 		"snowflake_system_generate_scim_access_token":  datasources.SystemGenerateSCIMAccessToken(),
 		"snowflake_system_get_aws_sns_iam_policy":      datasources.SystemGetAWSSNSIAMPolicy(),
 		"snowflake_system_get_privatelink_config":      datasources.SystemGetPrivateLinkConfig(),
@@ -276,6 +328,7 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_stages":                             datasources.Stages(),
 		"snowflake_file_formats":                       datasources.FileFormats(),
 		"snowflake_sequences":                          datasources.Sequences(),
+		"snowflake_stream_grants":                      datasources.StreamGrants(),
 		"snowflake_streams":                            datasources.Streams(),
 		"snowflake_tasks":                              datasources.Tasks(),
 		"snowflake_masking_policies":                   datasources.MaskingPolicies(),
@@ -295,6 +348,25 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_roles":                              datasources.Roles(),
 		"snowflake_users":                              datasources.Users(),
 		"snowflake_grants":                             datasources.Grants(),
+		"snowflake_alerts":                             datasources.Alerts(),
+		"snowflake_cortex_search_services":             datasources.CortexSearchServices(),
+		"snowflake_secrets":                            datasources.Secrets(),
+		"snowflake_network_rules":                      datasources.NetworkRules(),
+		"snowflake_external_access_integrations":       datasources.ExternalAccessIntegrations(),
+		"snowflake_integrations":                       datasources.Integrations(),
+		"snowflake_event_tables":                       datasources.EventTables(),
+		"snowflake_image_repositories":                 datasources.ImageRepositories(),
+		"snowflake_services":                           datasources.Services(),
+		"snowflake_compute_pools":                      datasources.ComputePools(),
+		"snowflake_git_repositories":                   datasources.GitRepositories(),
+		"snowflake_iceberg_tables":                     datasources.IcebergTables(),
+		"snowflake_password_policies":                  datasources.PasswordPolicies(),
+		"snowflake_session_policies":                   datasources.SessionPolicies(),
+		"snowflake_authentication_policies":            datasources.AuthenticationPolicies(),
+		"snowflake_aggregation_policies":               datasources.AggregationPolicies(),
+		"snowflake_projection_policies":                datasources.ProjectionPolicies(),
+		"snowflake_tag_associations":                   datasources.TagAssociations(),
+		"snowflake_notification_integrations":          datasources.NotificationIntegrations(),
 	}
 
 	return dataSources
@@ -320,6 +392,11 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 	protocol := s.Get("protocol").(string)
 	port := s.Get("port").(int)
 	warehouse := s.Get("warehouse").(string)
+	params := map[string]*string{}
+	if s.Get("client_request_mfa_token").(bool) {
+		requested := "true"
+		params[clientRequestMfaTokenParam] = &requested
+	}
 
 	if oauthRefreshToken != "" {
 		accessToken, err := GetOauthAccessToken(oauthEndpoint, oauthClientID, oauthClientSecret, GetOauthData(oauthRefreshToken, oauthRedirectURL))
@@ -344,19 +421,48 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 		protocol,
 		port,
 		warehouse,
+		params,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("could not build dsn for snowflake connection err = %w", err)
 	}
 
-	db, err := db.Open(dsn)
+	db, err := db.Open(dsn, s.Get("log_statements").(bool))
 	if err != nil {
 		return nil, fmt.Errorf("Could not open snowflake database err = %w", err)
 	}
 
+	if s.Get("serialize_grants_per_object").(bool) {
+		resources.SerializeGrantsPerObject(db)
+	}
+
+	ConfigureConnectionPool(
+		db,
+		s.Get("max_open_connections").(int),
+		s.Get("max_idle_connections").(int),
+		time.Duration(s.Get("connection_max_lifetime_seconds").(int))*time.Second,
+	)
+
 	return db, nil
 }
 
+// ConfigureConnectionPool applies the connection pool settings of the provider to db. Zero values
+// leave the corresponding database/sql default in place.
+func ConfigureConnectionPool(db *sql.DB, maxOpenConnections int, maxIdleConnections int, connectionMaxLifetime time.Duration) {
+	if maxOpenConnections > 0 {
+		db.SetMaxOpenConns(maxOpenConnections)
+	}
+	if maxIdleConnections > 0 {
+		db.SetMaxIdleConns(maxIdleConnections)
+	}
+	if connectionMaxLifetime > 0 {
+		db.SetConnMaxLifetime(connectionMaxLifetime)
+	}
+}
+
+// clientRequestMfaTokenParam is the connection parameter gosnowflake reads to cache the MFA token.
+const clientRequestMfaTokenParam = "CLIENT_REQUEST_MFA_TOKEN"
+
 func DSN(
 	account string,
 	user string,
@@ -372,6 +478,7 @@ func DSN(
 	protocol string,
 	port int,
 	warehouse string,
+	params map[string]*string,
 ) (string, error) {
 	// us-west-2 is Snowflake's default region, but if you actually specify that it won't trigger the default code
 	//  https://github.com/snowflakedb/gosnowflake/blob/52137ce8c32eaf93b0bd22fc5c7297beff339812/dsn.go#L61
@@ -387,6 +494,7 @@ func DSN(
 		Application: "terraform-provider-snowflake",
 		Port:        port,
 		Protocol:    protocol,
+		Params:      params,
 	}
 
 	// If host is set trust it and do not use the region value
@@ -425,6 +533,10 @@ func DSN(
 		config.Token = oauthAccessToken
 	} else if password != "" {
 		config.Password = password
+		// The driver only caches the MFA token for the username_password_mfa authenticator.
+		if requested, ok := params[clientRequestMfaTokenParam]; ok && *requested == "true" {
+			config.Authenticator = gosnowflake.AuthTypeUsernamePasswordMFA
+		}
 	} else {
 		return "", errors.New("no authentication method provided")
 	}
@@ -569,6 +681,7 @@ func GetDatabaseHandleFromEnv() (db *sql.DB, err error) {
 		protocol,
 		port,
 		warehouse,
+		nil,
 	)
 	if err != nil {
 		return nil, err
@@ -2,6 +2,7 @@ package provider_test
 
 import (
 	"bytes"
+	"database/sql"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -10,8 +11,12 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/snowflakedb/gosnowflake"
 	"github.com/stretchr/testify/require"
 )
 
@@ -64,7 +69,7 @@ func TestDSN(t *testing.T) {
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := provider.DSN(tt.args.account, tt.args.user, tt.args.password, tt.args.browserAuth, "", "", "", "", tt.args.region, tt.args.role, tt.args.host, tt.args.protocol, tt.args.port, "")
+			got, err := provider.DSN(tt.args.account, tt.args.user, tt.args.password, tt.args.browserAuth, "", "", "", "", tt.args.region, tt.args.role, tt.args.host, tt.args.protocol, tt.args.port, "", nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("DSN() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -76,6 +81,52 @@ func TestDSN(t *testing.T) {
 	}
 }
 
+func TestDSNClientRequestMfaToken(t *testing.T) {
+	r := require.New(t)
+	requested := "true"
+	got, err := provider.DSN("acct", "user", "pass", false, "", "", "", "", "region", "role", "", "https", 443, "", map[string]*string{
+		"CLIENT_REQUEST_MFA_TOKEN": &requested,
+	})
+	r.NoError(err)
+
+	cfg, err := gosnowflake.ParseDSN(got)
+	r.NoError(err)
+	r.Equal(gosnowflake.AuthTypeUsernamePasswordMFA, cfg.Authenticator)
+	r.NotNil(cfg.Params["CLIENT_REQUEST_MFA_TOKEN"])
+	r.Equal("true", *cfg.Params["CLIENT_REQUEST_MFA_TOKEN"])
+}
+
+func TestConfigureProviderConnectionPool(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{
+		"account":                         "acct",
+		"username":                        "user",
+		"password":                        "pass",
+		"max_open_connections":            5,
+		"max_idle_connections":            3,
+		"connection_max_lifetime_seconds": 60,
+	})
+
+	meta, err := provider.ConfigureProvider(d)
+	r.NoError(err)
+	db := meta.(*sql.DB)
+	defer db.Close()
+	r.Equal(5, db.Stats().MaxOpenConnections)
+}
+
+func TestConfigureConnectionPool(t *testing.T) {
+	r := require.New(t)
+	db, _, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	provider.ConfigureConnectionPool(db, 0, 0, 0)
+	r.Equal(0, db.Stats().MaxOpenConnections)
+
+	provider.ConfigureConnectionPool(db, 10, 4, time.Minute)
+	r.Equal(10, db.Stats().MaxOpenConnections)
+}
+
 // nolint: gosec
 func TestOAuthDSN(t *testing.T) {
 	type args struct {
@@ -114,7 +165,7 @@ func TestOAuthDSN(t *testing.T) {
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := provider.DSN(tt.args.account, tt.args.user, "", false, "", "", "", tt.args.oauthAccessToken, tt.args.region, tt.args.role, tt.args.host, tt.args.protocol, tt.args.port, "")
+			got, err := provider.DSN(tt.args.account, tt.args.user, "", false, "", "", "", tt.args.oauthAccessToken, tt.args.region, tt.args.role, tt.args.host, tt.args.protocol, tt.args.port, "", nil)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("DSN() error = %v, dsn = %v, wantErr %v", err, got, tt.wantErr)
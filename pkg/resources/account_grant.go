@@ -11,40 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validAccountPrivileges = NewPrivilegeSet(
-	privilegeApplyMaskingPolicy,
-	privilegeApplyPasswordPolicy,
-	privilegeApplyRowAccessPolicy,
-	privilegeApplySessionPolicy,
-	privilegeApplyTag,
-	privilegeAttachPolicy,
-	privilegeAudit,
-	privilegeCreateAccount,
-	privilegeCreateCredential,
-	privilegeCreateDatabase,
-	privilegeCreateDataExchangeListing,
-	privilegeCreateFailoverGroup,
-	privilegeCreateIntegration,
-	privilegeCreateNetworkPolicy,
-	privilegeCreateRole,
-	privilegeCreateShare,
-	privilegeCreateUser,
-	privilegeCreateWarehouse,
-	privilegeExecuteTask,
-	privilegeImportShare,
-	privilegeManageGrants,
-	privilegeMonitor,
-	privilegeMonitorUsage,
-	privilegeMonitorExecution,
-	privilegeMonitorSecurity,
-	privilegeOverrideShareRestrictions,
-	privilegeExecuteManagedTask,
-	privilegeOrganizationSupportCases,
-	privilegeProvisionApplication,
-	privilegePurchaseDataExchangeListing,
-	privilegeAccountSupportCases,
-	privilegeUserSupportCases,
-)
+var validAccountPrivileges = privilegesFor("ACCOUNT")
 
 var accountGrantSchema = map[string]*schema.Schema{
 	"privilege": {
@@ -74,6 +41,10 @@ var accountGrantSchema = map[string]*schema.Schema{
 		Description: "When this is set to true, multiple grants of the same type can be created. This will cause Terraform to not revoke grants applied to roles and objects outside Terraform.",
 		Default:     false,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 }
 
 // AccountGrant returns a pointer to the resource representing an account grant.
@@ -223,12 +194,12 @@ func UpdateAccountGrant(d *schema.ResourceData, meta interface{}) error {
 	withGrantOption := d.Get("with_grant_option").(bool)
 
 	// first revoke
-	if err := deleteGenericGrantRolesAndShares(meta, builder, privilege, rolesToRevoke, nil); err != nil {
+	if err := deleteGenericGrantRolesAndShares(meta, d.Get("as_role").(string), builder, privilege, rolesToRevoke, nil); err != nil {
 		return err
 	}
 
 	// then add
-	if err := createGenericGrantRolesAndShares(meta, builder, privilege, withGrantOption, rolesToAdd, nil); err != nil {
+	if err := createGenericGrantRolesAndShares(meta, d.Get("as_role").(string), d.Get("current_grants").(string), builder, privilege, withGrantOption, rolesToAdd, nil); err != nil {
 		return err
 	}
 
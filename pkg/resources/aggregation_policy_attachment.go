@@ -0,0 +1,222 @@
+package resources
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
+)
+
+const (
+	aggregationPolicyAttachmentIDDelimiter = '|'
+	aggregationPolicyKind                  = "AGGREGATION_POLICY"
+)
+
+var aggregationPolicyAttachmentObjectTypes = []string{"TABLE", "VIEW", "MATERIALIZED_VIEW"}
+
+var aggregationPolicyAttachmentSchema = map[string]*schema.Schema{
+	"aggregation_policy": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "The fully qualified name of the aggregation policy, either \"databaseName\".\"schemaName\".\"policyName\", databaseName.schemaName.policyName or databaseName|schemaName|policyName.",
+		ValidateFunc: snowflakeValidation.ValidateFullyQualifiedObjectID,
+	},
+	"object_type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  fmt.Sprintf("The type of the object the aggregation policy is attached to, one of %v.", strings.Join(aggregationPolicyAttachmentObjectTypes, ", ")),
+		ValidateFunc: validation.StringInSlice(aggregationPolicyAttachmentObjectTypes, false),
+	},
+	"object_name": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "The fully qualified name of the object the aggregation policy is attached to, either \"databaseName\".\"schemaName\".\"objectName\", databaseName.schemaName.objectName or databaseName|schemaName|objectName.",
+		ValidateFunc: snowflakeValidation.ValidateFullyQualifiedObjectID,
+	},
+	"entity_key": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The columns identifying an entity, e.g. a customer, so that aggregation groups are counted in entities rather than rows. Snowflake does not report the entity key, so it is not read back.",
+	},
+}
+
+// AggregationPolicyAttachment returns a pointer to the resource representing an aggregation policy
+// attached to a table or view.
+func AggregationPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateAggregationPolicyAttachment,
+		Read:   ReadAggregationPolicyAttachment,
+		Delete: DeleteAggregationPolicyAttachment,
+
+		Schema: aggregationPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+type aggregationPolicyAttachmentID struct {
+	ObjectType         string
+	DatabaseName       string
+	SchemaName         string
+	ObjectName         string
+	PolicyDatabaseName string
+	PolicySchemaName   string
+	PolicyName         string
+}
+
+// String() takes in an aggregationPolicyAttachmentID object and returns a pipe-delimited string:
+// ObjectType|DatabaseName|SchemaName|ObjectName|PolicyDatabaseName|PolicySchemaName|PolicyName.
+func (id *aggregationPolicyAttachmentID) String() (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = aggregationPolicyAttachmentIDDelimiter
+	dataIdentifiers := [][]string{{id.ObjectType, id.DatabaseName, id.SchemaName, id.ObjectName, id.PolicyDatabaseName, id.PolicySchemaName, id.PolicyName}}
+	if err := csvWriter.WriteAll(dataIdentifiers); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// aggregationPolicyAttachmentIDFromString() takes in a pipe-delimited string:
+// ObjectType|DatabaseName|SchemaName|ObjectName|PolicyDatabaseName|PolicySchemaName|PolicyName
+// and returns an aggregationPolicyAttachmentID object.
+func aggregationPolicyAttachmentIDFromString(stringID string) (*aggregationPolicyAttachmentID, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = aggregationPolicyAttachmentIDDelimiter
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("not CSV compatible")
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("1 line per aggregation policy attachment")
+	}
+	if len(lines[0]) != 7 {
+		return nil, fmt.Errorf("7 fields allowed")
+	}
+
+	return &aggregationPolicyAttachmentID{
+		ObjectType:         lines[0][0],
+		DatabaseName:       lines[0][1],
+		SchemaName:         lines[0][2],
+		ObjectName:         lines[0][3],
+		PolicyDatabaseName: lines[0][4],
+		PolicySchemaName:   lines[0][5],
+		PolicyName:         lines[0][6],
+	}, nil
+}
+
+func (id *aggregationPolicyAttachmentID) builder() *snowflake.AggregationPolicyAttachmentBuilder {
+	policy := fmt.Sprintf(`"%v"."%v"."%v"`, id.PolicyDatabaseName, id.PolicySchemaName, id.PolicyName)
+	return snowflake.AggregationPolicyAttachment(id.ObjectType, id.DatabaseName, id.SchemaName, id.ObjectName).WithPolicy(policy)
+}
+
+// CreateAggregationPolicyAttachment implements schema.CreateFunc.
+func CreateAggregationPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	objectDB, objectSchema, objectName := snowflakeValidation.ParseFullyQualifiedObjectID(d.Get("object_name").(string))
+	policyDB, policySchema, policyName := snowflakeValidation.ParseFullyQualifiedObjectID(d.Get("aggregation_policy").(string))
+	id := &aggregationPolicyAttachmentID{
+		ObjectType:         d.Get("object_type").(string),
+		DatabaseName:       objectDB,
+		SchemaName:         objectSchema,
+		ObjectName:         objectName,
+		PolicyDatabaseName: policyDB,
+		PolicySchemaName:   policySchema,
+		PolicyName:         policyName,
+	}
+
+	builder := id.builder().WithEntityKey(expandStringList(d.Get("entity_key").([]interface{})))
+	if err := snowflake.Exec(db, builder.Set()); err != nil {
+		return fmt.Errorf("error setting aggregation policy %v on %v %v err = %w", policyName, strings.ToLower(id.ObjectType), objectName, err)
+	}
+
+	idString, err := id.String()
+	if err != nil {
+		return err
+	}
+	d.SetId(idString)
+
+	return ReadAggregationPolicyAttachment(d, meta)
+}
+
+// ReadAggregationPolicyAttachment implements schema.ReadFunc.
+func ReadAggregationPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	id, err := aggregationPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	references, err := snowflake.ListPolicyReferences(id.builder().Show(), db)
+	if err != nil {
+		if isObjectNotExistError(err) {
+			log.Printf("[DEBUG] %v of aggregation policy attachment (%s) not found", strings.ToLower(id.ObjectType), d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	found := false
+	for _, reference := range references {
+		if reference.PolicyKind.String == aggregationPolicyKind &&
+			reference.PolicyDB.String == id.PolicyDatabaseName &&
+			reference.PolicySchema.String == id.PolicySchemaName &&
+			reference.PolicyName.String == id.PolicyName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		log.Printf("[DEBUG] aggregation policy attachment (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("object_type", id.ObjectType); err != nil {
+		return err
+	}
+	// keep the format the object and policy names were configured in
+	objectDB, objectSchema, objectName := snowflakeValidation.ParseFullyQualifiedObjectID(d.Get("object_name").(string))
+	if objectDB != id.DatabaseName || objectSchema != id.SchemaName || objectName != id.ObjectName {
+		if err := d.Set("object_name", fmt.Sprintf("%v|%v|%v", id.DatabaseName, id.SchemaName, id.ObjectName)); err != nil {
+			return err
+		}
+	}
+	policyDB, policySchema, policyName := snowflakeValidation.ParseFullyQualifiedObjectID(d.Get("aggregation_policy").(string))
+	if policyDB != id.PolicyDatabaseName || policySchema != id.PolicySchemaName || policyName != id.PolicyName {
+		return d.Set("aggregation_policy", fmt.Sprintf("%v|%v|%v", id.PolicyDatabaseName, id.PolicySchemaName, id.PolicyName))
+	}
+	return nil
+}
+
+// DeleteAggregationPolicyAttachment implements schema.DeleteFunc.
+func DeleteAggregationPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	id, err := aggregationPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := snowflake.Exec(db, id.builder().Unset()); err != nil {
+		return fmt.Errorf("error unsetting aggregation policy %v from %v %v err = %w", id.PolicyName, strings.ToLower(id.ObjectType), id.ObjectName, err)
+	}
+
+	d.SetId("")
+	return nil
+}
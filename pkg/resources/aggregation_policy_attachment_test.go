@@ -0,0 +1,101 @@
+package resources_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+const tableAggregationPolicyReferences = `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"db"."schema"."table"', REF_ENTITY_DOMAIN => 'TABLE'))`
+
+func TestAggregationPolicyAttachment(t *testing.T) {
+	r := require.New(t)
+	err := resources.AggregationPolicyAttachment().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestAggregationPolicyAttachmentCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"aggregation_policy": "ap_db.ap_schema.ap_name",
+		"object_type":        "TABLE",
+		"object_name":        "db|schema|table",
+		"entity_key":         []interface{}{"customer_id"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.AggregationPolicyAttachment().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER TABLE "db"."schema"."table" SET AGGREGATION POLICY "ap_db"."ap_schema"."ap_name" ENTITY KEY \("customer_id"\)$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND"}).
+			AddRow("ap_db", "ap_schema", "ap_name", "AGGREGATION_POLICY")
+		mock.ExpectQuery(regexp.QuoteMeta(tableAggregationPolicyReferences)).WillReturnRows(rows)
+
+		err := resources.CreateAggregationPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Equal("TABLE|db|schema|table|ap_db|ap_schema|ap_name", d.Id())
+		r.Equal("db|schema|table", d.Get("object_name").(string))
+		r.Equal("ap_db.ap_schema.ap_name", d.Get("aggregation_policy").(string))
+		r.Equal([]interface{}{"customer_id"}, d.Get("entity_key").([]interface{}))
+	})
+}
+
+func TestAggregationPolicyAttachmentRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.AggregationPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("MATERIALIZED_VIEW|db|schema|mv|ap_db|ap_schema|ap_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND"}).
+			AddRow("ap_db", "ap_schema", "ap_name", "AGGREGATION_POLICY")
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM TABLE("db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"db"."schema"."mv"', REF_ENTITY_DOMAIN => 'VIEW'))`)).WillReturnRows(rows)
+
+		err := resources.ReadAggregationPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Equal("MATERIALIZED_VIEW", d.Get("object_type").(string))
+		r.Equal("db|schema|mv", d.Get("object_name").(string))
+		r.Equal("ap_db|ap_schema|ap_name", d.Get("aggregation_policy").(string))
+	})
+}
+
+func TestAggregationPolicyAttachmentReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.AggregationPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("TABLE|db|schema|table|ap_db|ap_schema|ap_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// another aggregation policy replaced ours
+		rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND"}).
+			AddRow("ap_db", "ap_schema", "other", "AGGREGATION_POLICY")
+		mock.ExpectQuery(regexp.QuoteMeta(tableAggregationPolicyReferences)).WillReturnRows(rows)
+
+		err := resources.ReadAggregationPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func TestAggregationPolicyAttachmentDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.AggregationPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("VIEW|db|schema|view|ap_db|ap_schema|ap_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER VIEW "db"."schema"."view" UNSET AGGREGATION POLICY$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := resources.DeleteAggregationPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
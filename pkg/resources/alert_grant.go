@@ -0,0 +1,224 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var validAlertPrivileges = privilegesFor("ALERT")
+
+var alertGrantSchema = map[string]*schema.Schema{
+	"alert_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The name of the alert on which to grant privileges immediately (only valid if on_future is false).",
+		ForceNew:    true,
+	},
+	"database_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the database containing the current or future alerts on which to grant privileges.",
+		ForceNew:    true,
+	},
+	"enable_multiple_grants": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "When this is set to true, multiple grants of the same type can be created. This will cause Terraform to not revoke grants applied to roles and objects outside Terraform.",
+		Default:     false,
+		ForceNew:    true,
+	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
+	"on_future": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "When this is set to true and a schema_name is provided, apply this grant on all future alerts in the given schema. When this is true and no schema_name is provided apply this grant on all future alerts in the given database. The alert_name field must be unset in order to use on_future.",
+		Default:     false,
+		ForceNew:    true,
+	},
+	"revoke_existing_on_delete": revokeExistingOnDeleteSchema,
+	"privilege": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "The privilege to grant on the current or future alert. Changing the privilege revokes the old one and grants the new one in place.",
+		Default:      "OPERATE",
+		ValidateFunc: validation.StringInSlice(validAlertPrivileges.ToList(), true),
+	},
+	"roles": {
+		Type:        schema.TypeSet,
+		Required:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Grants privilege to these roles.",
+	},
+	"schema_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The name of the schema containing the current or future alerts on which to grant privileges.",
+		ForceNew:    true,
+	},
+	"with_grant_option": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "When this is set to true, allows the recipient role to grant the privileges to other roles.",
+		Default:     false,
+		ForceNew:    true,
+	},
+}
+
+var alertGrantCRUD = grantResourceCRUD(alertGrantSchema, alertGrantBuilder, validAlertPrivileges, grantIDCodec{
+	fromConfig: alertGrantIDFromConfig,
+	parse: func(s string) (grantID, error) {
+		return parseAlertGrantID(s)
+	},
+})
+
+var (
+	// CreateAlertGrant implements schema.CreateFunc.
+	CreateAlertGrant = alertGrantCRUD.Create
+	// ReadAlertGrant implements schema.ReadFunc.
+	ReadAlertGrant = alertGrantCRUD.Read
+	// UpdateAlertGrant implements schema.UpdateFunc.
+	UpdateAlertGrant = alertGrantCRUD.Update
+	// DeleteAlertGrant implements schema.DeleteFunc.
+	DeleteAlertGrant = alertGrantCRUD.Delete
+)
+
+// AlertGrant returns a pointer to the resource representing an alert grant.
+func AlertGrant() *TerraformGrantResource {
+	return &TerraformGrantResource{
+		Resource: &schema.Resource{
+			Create: CreateAlertGrant,
+			Read:   ReadAlertGrant,
+			Delete: DeleteAlertGrant,
+			Update: UpdateAlertGrant,
+
+			Schema: alertGrantSchema,
+			Importer: &schema.ResourceImporter{
+				StateContext: importFutureGrantWildcard(snowflake.FutureAlertGrant, func(g *futureGrantImport) string {
+					return NewAlertGrantID(g.DatabaseName, g.SchemaName, "", g.Privilege, g.Roles, g.WithGrantOption).String()
+				}),
+			},
+		},
+		ValidPrivs: validAlertPrivileges,
+	}
+}
+
+func alertGrantBuilder(id grantID) snowflake.GrantBuilder {
+	alertID := id.(*AlertGrantID)
+	if alertID.onFuture() {
+		return snowflake.FutureAlertGrant(alertID.DatabaseName, alertID.SchemaName)
+	}
+	return snowflake.AlertGrant(alertID.DatabaseName, alertID.SchemaName, alertID.ObjectName)
+}
+
+func alertGrantIDFromConfig(d *schema.ResourceData) (grantID, error) {
+	var alertName string
+	if name, ok := d.GetOk("alert_name"); ok {
+		alertName = name.(string)
+	}
+	databaseName := d.Get("database_name").(string)
+	schemaName := d.Get("schema_name").(string)
+	privilege := d.Get("privilege").(string)
+	onFuture := d.Get("on_future").(bool)
+	withGrantOption := d.Get("with_grant_option").(bool)
+	roles := expandStringList(d.Get("roles").(*schema.Set).List())
+
+	if (alertName == "") && !onFuture {
+		return nil, errors.New("alert_name must be set unless on_future is true")
+	}
+	if (alertName != "") && onFuture {
+		return nil, errors.New("alert_name must be empty if on_future is true")
+	}
+	if (schemaName == "") && !onFuture {
+		return nil, errors.New("schema_name must be set unless on_future is true")
+	}
+	grantID := NewAlertGrantID(databaseName, schemaName, alertName, privilege, roles, withGrantOption)
+	grantID.EnableMultipleGrants = d.Get("enable_multiple_grants").(bool)
+	return grantID, nil
+}
+
+type AlertGrantID struct {
+	DatabaseName         string
+	SchemaName           string
+	ObjectName           string
+	Privilege            string
+	Roles                []string
+	WithGrantOption      bool
+	EnableMultipleGrants bool
+}
+
+func NewAlertGrantID(databaseName string, schemaName, objectName, privilege string, roles []string, withGrantOption bool) *AlertGrantID {
+	return &AlertGrantID{
+		DatabaseName:    databaseName,
+		SchemaName:      schemaName,
+		ObjectName:      objectName,
+		Privilege:       privilege,
+		Roles:           roles,
+		WithGrantOption: withGrantOption,
+	}
+}
+
+func (v *AlertGrantID) privilege() string {
+	return v.Privilege
+}
+
+func (v *AlertGrantID) withGrantOption() bool {
+	return v.WithGrantOption
+}
+
+func (v *AlertGrantID) onFuture() bool {
+	return v.ObjectName == ""
+}
+
+func (v *AlertGrantID) onAll() bool {
+	return false
+}
+
+func (v *AlertGrantID) setObject(d *schema.ResourceData) error {
+	if err := d.Set("database_name", v.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema_name", v.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("alert_name", v.ObjectName); err != nil {
+		return err
+	}
+	if err := d.Set("on_future", v.onFuture()); err != nil {
+		return err
+	}
+	if err := seedGrantees(d, v.Roles, nil); err != nil {
+		return err
+	}
+	return d.Set("enable_multiple_grants", v.EnableMultipleGrants)
+}
+
+func (v *AlertGrantID) String() string {
+	// names may contain the separator, so they are escaped
+	roles := escapeGrantIDList(v.Roles)
+	return fmt.Sprintf("%v❄️%v❄️%v❄️%v❄️%v❄️%v❄️%v",
+		escapeGrantIDPart(v.DatabaseName), escapeGrantIDPart(v.SchemaName), escapeGrantIDPart(v.ObjectName), v.Privilege, v.WithGrantOption, roles, v.EnableMultipleGrants)
+}
+
+func parseAlertGrantID(s string) (*AlertGrantID, error) {
+	idParts := strings.Split(s, "❄️")
+	if len(idParts) != 7 {
+		return nil, fmt.Errorf("unexpected number of ID parts (%d), expected 7", len(idParts))
+	}
+	return &AlertGrantID{
+		DatabaseName:         unescapeGrantIDPart(idParts[0]),
+		SchemaName:           unescapeGrantIDPart(idParts[1]),
+		ObjectName:           unescapeGrantIDPart(idParts[2]),
+		Privilege:            idParts[3],
+		WithGrantOption:      idParts[4] == "true",
+		Roles:                unescapeGrantIDList(idParts[5]),
+		EnableMultipleGrants: idParts[6] == "true",
+	}, nil
+}
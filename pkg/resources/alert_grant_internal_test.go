@@ -0,0 +1,23 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertGrantIDEscapesSeparators(t *testing.T) {
+	r := require.New(t)
+
+	id := NewAlertGrantID("db❄️1", "PUBLIC", "alert❄️name", "OPERATE", []string{"role,a", "role❄️b"}, true)
+	s := id.String()
+	r.Equal("db__SNOWFLAKE__1❄️PUBLIC❄️alert__SNOWFLAKE__name❄️OPERATE❄️true❄️role__COMMA__a,role__SNOWFLAKE__b❄️false", s)
+
+	parsed, err := parseAlertGrantID(s)
+	r.NoError(err)
+	r.Equal("db❄️1", parsed.DatabaseName)
+	r.Equal("PUBLIC", parsed.SchemaName)
+	r.Equal("alert❄️name", parsed.ObjectName)
+	r.Equal([]string{"role,a", "role❄️b"}, parsed.Roles)
+	r.Equal(s, parsed.String())
+}
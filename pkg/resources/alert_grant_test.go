@@ -0,0 +1,236 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertGrant(t *testing.T) {
+	r := require.New(t)
+	err := resources.AlertGrant().Resource.InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestAlertGrantCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"alert_name":        "test-alert",
+		"schema_name":       "PUBLIC",
+		"database_name":     "test-db",
+		"privilege":         "OPERATE",
+		"roles":             []interface{}{"test-role-1", "test-role-2"},
+		"with_grant_option": true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.AlertGrant().Resource.Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^GRANT OPERATE ON ALERT "test-db"."PUBLIC"."test-alert" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^GRANT OPERATE ON ALERT "test-db"."PUBLIC"."test-alert" TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadAlertGrant(mock)
+		err := resources.CreateAlertGrant(d, db)
+		r.NoError(err)
+	})
+	r.Equal(2, d.Get("roles").(*schema.Set).Len())
+	r.True(d.Get("with_grant_option").(bool))
+}
+
+func TestAlertGrantCreateRequiresAlertName(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.AlertGrant().Resource.Schema, map[string]interface{}{
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"roles":         []interface{}{"test-role-1"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		err := resources.CreateAlertGrant(d, db)
+		r.EqualError(err, "alert_name must be set unless on_future is true")
+		r.Equal("", d.Id())
+	})
+}
+
+func TestAlertGrantRead(t *testing.T) {
+	r := require.New(t)
+
+	d := alertGrant(t, "test-db❄️PUBLIC❄️test-alert❄️OPERATE❄️false❄️test-role-1❄️false", map[string]interface{}{
+		"alert_name":    "test-alert",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "OPERATE",
+		"roles":         []interface{}{"test-role-1"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadAlertGrant(mock)
+		err := resources.ReadAlertGrant(d, db)
+		r.NoError(err)
+	})
+
+	roles := d.Get("roles").(*schema.Set)
+	r.True(roles.Contains("test-role-1"))
+	r.True(roles.Contains("test-role-2"))
+	r.Equal(2, roles.Len())
+	r.Equal("test-alert", d.Get("alert_name"))
+	r.False(d.Get("on_future").(bool))
+}
+
+func TestAlertGrantImportEnableMultipleGrants(t *testing.T) {
+	r := require.New(t)
+
+	d := alertGrant(t, "test-db❄️PUBLIC❄️test-alert❄️OPERATE❄️false❄️test-role-1❄️true", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadAlertGrant(mock)
+		err := resources.ReadAlertGrant(d, db)
+		r.NoError(err)
+	})
+	r.True(d.Get("enable_multiple_grants").(bool))
+	// the roles of the ID are kept, the grants of other roles are not claimed by the imported resource
+	roles := d.Get("roles").(*schema.Set)
+	r.True(roles.Contains("test-role-1"))
+	r.Equal(1, roles.Len())
+}
+
+func TestAlertGrantUpdateRoles(t *testing.T) {
+	r := require.New(t)
+
+	params := map[string]interface{}{
+		"alert_name":    "test-alert",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "OPERATE",
+		"roles":         []interface{}{"test-role-1", "test-role-3"},
+	}
+	newParams := map[string]interface{}{
+		"alert_name":    "test-alert",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "OPERATE",
+		"roles":         []interface{}{"test-role-1", "test-role-2"},
+	}
+	d := alertGrantUpdate(t, "test-db❄️PUBLIC❄️test-alert❄️OPERATE❄️false❄️test-role-1,test-role-3❄️false", params, newParams)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`^REVOKE OPERATE ON ALERT "test-db"."PUBLIC"."test-alert" FROM ROLE "test-role-3"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectExec(`^GRANT OPERATE ON ALERT "test-db"."PUBLIC"."test-alert" TO ROLE "test-role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadAlertGrant(mock)
+		err := resources.UpdateAlertGrant(d, db)
+		r.NoError(err)
+	})
+}
+
+func TestAlertGrantDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := alertGrant(t, "test-db❄️PUBLIC❄️test-alert❄️OWNERSHIP❄️false❄️test-role-1❄️false", map[string]interface{}{
+		"alert_name":    "test-alert",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "OWNERSHIP",
+		"roles":         []interface{}{"test-role-1"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// ownership cannot be revoked, it is transferred back to the current role
+		mock.ExpectBegin()
+		mock.ExpectExec(`^SET currentRole=CURRENT_ROLE\(\)$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^GRANT OWNERSHIP ON ALERT "test-db"."PUBLIC"."test-alert" TO ROLE IDENTIFIER\(\$currentRole\) COPY CURRENT GRANTS$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		err := resources.DeleteAlertGrant(d, db)
+		r.NoError(err)
+		r.Equal("", d.Id())
+	})
+}
+
+func TestFutureAlertGrantCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"on_future":     true,
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "OPERATE",
+		"roles":         []interface{}{"test-role-1"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.AlertGrant().Resource.Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^GRANT OPERATE ON FUTURE ALERTS IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadFutureAlertGrant(mock)
+		err := resources.CreateAlertGrant(d, db)
+		r.NoError(err)
+	})
+	r.Equal("test-db❄️PUBLIC❄️❄️OPERATE❄️false❄️test-role-1❄️false", d.Id())
+	r.True(d.Get("on_future").(bool))
+	r.True(d.Get("roles").(*schema.Set).Contains("test-role-1"))
+}
+
+func TestFutureAlertGrantCreateRejectsAlertName(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.AlertGrant().Resource.Schema, map[string]interface{}{
+		"on_future":     true,
+		"alert_name":    "test-alert",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"roles":         []interface{}{"test-role-1"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		err := resources.CreateAlertGrant(d, db)
+		r.EqualError(err, "alert_name must be empty if on_future is true")
+	})
+}
+
+func TestFutureAlertGrantDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := alertGrant(t, "test-db❄️❄️❄️OPERATE❄️false❄️test-role-1❄️false", map[string]interface{}{
+		"on_future":     true,
+		"database_name": "test-db",
+		"privilege":     "OPERATE",
+		"roles":         []interface{}{"test-role-1"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`^REVOKE OPERATE ON FUTURE ALERTS IN DATABASE "test-db" FROM ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		err := resources.DeleteAlertGrant(d, db)
+		r.NoError(err)
+	})
+}
+
+func expectReadAlertGrant(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{
+		"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+	}).AddRow(
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "OPERATE", "ALERT", "test-alert", "ROLE", "test-role-1", false, "bob",
+	).AddRow(
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "OPERATE", "ALERT", "test-alert", "ROLE", "test-role-2", false, "bob",
+	)
+	mock.ExpectQuery(`^SHOW GRANTS ON ALERT "test-db"."PUBLIC"."test-alert"$`).WillReturnRows(rows)
+}
+
+func expectReadFutureAlertGrant(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{
+		"created_on", "privilege", "grant_on", "name", "grant_to", "grantee_name", "grant_option",
+	}).AddRow(
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "OPERATE", "ALERT", "test-db.PUBLIC.<ALERT>", "ROLE", "test-role-1", false,
+	)
+	mock.ExpectQuery(`^SHOW FUTURE GRANTS IN SCHEMA "test-db"."PUBLIC"$`).WillReturnRows(rows)
+}
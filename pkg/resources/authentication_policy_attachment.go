@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var authenticationPolicyAttachment = &policyAttachment{
+	policyKind:  "AUTHENTICATION",
+	policyKey:   "authentication_policy",
+	objectTypes: []string{"USER", "ROLE", "ACCOUNT"},
+}
+
+var authenticationPolicyAttachmentSchema = authenticationPolicyAttachment.schema()
+
+// AuthenticationPolicyAttachment returns a pointer to the resource representing an authentication policy
+// attached to a user, a role or the account.
+func AuthenticationPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateAuthenticationPolicyAttachment,
+		Read:   ReadAuthenticationPolicyAttachment,
+		Delete: DeleteAuthenticationPolicyAttachment,
+
+		Schema: authenticationPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateAuthenticationPolicyAttachment implements schema.CreateFunc.
+func CreateAuthenticationPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	return authenticationPolicyAttachment.create(d, meta)
+}
+
+// ReadAuthenticationPolicyAttachment implements schema.ReadFunc.
+func ReadAuthenticationPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	return authenticationPolicyAttachment.read(d, meta)
+}
+
+// DeleteAuthenticationPolicyAttachment implements schema.DeleteFunc.
+func DeleteAuthenticationPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	return authenticationPolicyAttachment.delete(d, meta)
+}
@@ -0,0 +1,118 @@
+package resources_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	roleAuthenticationPolicyReferences    = `SELECT * FROM TABLE("ap_db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"role"', REF_ENTITY_DOMAIN => 'ROLE'))`
+	accountAuthenticationPolicyReferences = `SELECT * FROM TABLE("ap_db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => CURRENT_ACCOUNT(), REF_ENTITY_DOMAIN => 'ACCOUNT'))`
+)
+
+func TestAuthenticationPolicyAttachment(t *testing.T) {
+	r := require.New(t)
+	err := resources.AuthenticationPolicyAttachment().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestAuthenticationPolicyAttachmentCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"authentication_policy": "ap_db.ap_schema.ap_name",
+		"object_type":           "ROLE",
+		"object_name":           "role",
+	}
+	d := schema.TestResourceDataRaw(t, resources.AuthenticationPolicyAttachment().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER ROLE "role" SET AUTHENTICATION POLICY "ap_db"."ap_schema"."ap_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND"}).
+			AddRow("ap_db", "ap_schema", "ap_name", "AUTHENTICATION_POLICY")
+		mock.ExpectQuery(regexp.QuoteMeta(roleAuthenticationPolicyReferences)).WillReturnRows(rows)
+
+		err := resources.CreateAuthenticationPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Equal("ROLE|role|ap_db|ap_schema|ap_name", d.Id())
+		r.Equal("ap_db.ap_schema.ap_name", d.Get("authentication_policy").(string))
+	})
+}
+
+func TestAuthenticationPolicyAttachmentCreateAccount(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"authentication_policy": "ap_db|ap_schema|ap_name",
+		"object_type":           "ACCOUNT",
+	}
+	d := schema.TestResourceDataRaw(t, resources.AuthenticationPolicyAttachment().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER ACCOUNT SET AUTHENTICATION POLICY "ap_db"."ap_schema"."ap_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND"}).
+			AddRow("ap_db", "ap_schema", "ap_name", "AUTHENTICATION_POLICY")
+		mock.ExpectQuery(regexp.QuoteMeta(accountAuthenticationPolicyReferences)).WillReturnRows(rows)
+
+		err := resources.CreateAuthenticationPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Equal("ACCOUNT||ap_db|ap_schema|ap_name", d.Id())
+	})
+}
+
+func TestAuthenticationPolicyAttachmentCreateWithoutObjectName(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"authentication_policy": "ap_db|ap_schema|ap_name",
+		"object_type":           "USER",
+	}
+	d := schema.TestResourceDataRaw(t, resources.AuthenticationPolicyAttachment().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		err := resources.CreateAuthenticationPolicyAttachment(d, db)
+		r.ErrorContains(err, "object_name is required when object_type is USER")
+	})
+}
+
+func TestAuthenticationPolicyAttachmentReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.AuthenticationPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("ROLE|role|ap_db|ap_schema|ap_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// another authentication policy is attached to the role
+		rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND"}).
+			AddRow("ap_db", "ap_schema", "other", "AUTHENTICATION_POLICY")
+		mock.ExpectQuery(regexp.QuoteMeta(roleAuthenticationPolicyReferences)).WillReturnRows(rows)
+
+		err := resources.ReadAuthenticationPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func TestAuthenticationPolicyAttachmentDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.AuthenticationPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("ACCOUNT||ap_db|ap_schema|ap_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER ACCOUNT UNSET AUTHENTICATION POLICY$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := resources.DeleteAuthenticationPolicyAttachment(d, db)
+		r.NoError(err)
+	})
+}
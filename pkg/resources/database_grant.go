@@ -6,19 +6,12 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validDatabasePrivileges = NewPrivilegeSet(
-	privilegeCreateSchema,
-	privilegeImportedPrivileges,
-	privilegeModify,
-	privilegeMonitor,
-	privilegeOwnership,
-	privilegeReferenceUsage,
-	privilegeUsage,
-)
+var validDatabasePrivileges = privilegesFor("DATABASE")
 
 var databaseGrantSchema = map[string]*schema.Schema{
 	"database_name": {
@@ -43,7 +36,7 @@ var databaseGrantSchema = map[string]*schema.Schema{
 	},
 	"shares": {
 		Type:        schema.TypeSet,
-		Elem:        &schema.Schema{Type: schema.TypeString},
+		Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: snowflakeValidation.ValidateShareName},
 		Optional:    true,
 		Description: "Grants privilege to these shares.",
 	},
@@ -60,6 +53,10 @@ var databaseGrantSchema = map[string]*schema.Schema{
 		Description: "When this is set to true, multiple grants of the same type can be created. This will cause Terraform to not revoke grants applied to roles and objects outside Terraform.",
 		Default:     false,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 }
 
 // DatabaseGrant returns a pointer to the resource representing a database grant.
@@ -163,6 +160,7 @@ func UpdateDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
 		meta,
+		d.Get("as_role").(string),
 		builder,
 		privilege,
 		rolesToRevoke,
@@ -174,6 +172,8 @@ func UpdateDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
 	// then add
 	if err := createGenericGrantRolesAndShares(
 		meta,
+		d.Get("as_role").(string),
+		d.Get("current_grants").(string),
 		builder,
 		privilege,
 		withGrantOption,
@@ -45,6 +45,31 @@ func TestDatabaseGrantCreate(t *testing.T) {
 	})
 }
 
+func TestDatabaseGrantCreateImportedPrivileges(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database_name": "SNOWFLAKE",
+		"privilege":     "IMPORTED PRIVILEGES",
+		"roles":         []interface{}{"test-role-1"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.DatabaseGrant().Resource.Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^GRANT IMPORTED PRIVILEGES ON DATABASE "SNOWFLAKE" TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{
+			"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+		}).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "IMPORTED PRIVILEGES", "DATABASE", "SNOWFLAKE", "ROLE", "test-role-1", false, "ACCOUNTADMIN",
+		)
+		mock.ExpectQuery(`^SHOW GRANTS ON DATABASE "SNOWFLAKE"$`).WillReturnRows(rows)
+		err := resources.CreateDatabaseGrant(d, db)
+		r.NoError(err)
+	})
+	r.Equal("IMPORTED PRIVILEGES", d.Get("privilege"))
+	r.True(d.Get("roles").(*schema.Set).Contains("test-role-1"))
+}
+
 func TestDatabaseGrantRead(t *testing.T) {
 	r := require.New(t)
 
@@ -74,6 +99,42 @@ func TestDatabaseGrantRead(t *testing.T) {
 	r.Equal(2, shares.Len())
 }
 
+func TestDatabaseGrantReadCreatedOnAndGrantedBy(t *testing.T) {
+	r := require.New(t)
+
+	d := databaseGrant(t, "test-database|||USAGE||false", map[string]interface{}{
+		"database_name":     "test-database",
+		"privilege":         "USAGE",
+		"roles":             []interface{}{"test-role-1"},
+		"with_grant_option": false,
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+		}).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "MONITOR", "DATABASE", "test-database", "ROLE", "test-role-1", false, "alice",
+		).AddRow(
+			time.Date(2001, 2, 3, 4, 5, 6, 0, time.UTC), "USAGE", "DATABASE", "test-database", "ROLE", "test-role-1", false, "bob",
+		).AddRow(
+			time.Date(2002, 1, 1, 0, 0, 0, 0, time.UTC), "USAGE", "DATABASE", "test-database", "ROLE", "test-role-2", false, "carol",
+		)
+		mock.ExpectQuery(`^SHOW GRANTS ON DATABASE "test-database"$`).WillReturnRows(rows)
+		err := resources.ReadDatabaseGrant(d, db)
+		r.NoError(err)
+	})
+
+	// every role holding the privilege is managed, since enable_multiple_grants is off
+	r.Equal(map[string]interface{}{
+		"test-role-1": "2001-02-03T04:05:06Z",
+		"test-role-2": "2002-01-01T00:00:00Z",
+	}, d.Get("created_on"))
+	r.Equal(map[string]interface{}{
+		"test-role-1": "bob",
+		"test-role-2": "carol",
+	}, d.Get("granted_by"))
+}
+
 func expectReadDatabaseGrant(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{
 		"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
@@ -168,6 +168,11 @@ var externalFunctionSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Description: "Date and time when the external function was created.",
 	},
+	"api_prefix": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The URL prefix of the API integration under which the external function calls the proxy service, as reported by DESCRIBE FUNCTION.",
+	},
 }
 
 // ExternalFunction returns a pointer to the resource representing an external function.
@@ -455,10 +460,7 @@ func ReadExternalFunction(d *schema.ResourceData, meta interface{}) error {
 			}
 		case "context_headers":
 			if desc.Value.Valid && desc.Value.String != "null" {
-				// Format in Snowflake DB is: ["CONTEXT_FUNCTION_1","CONTEXT_FUNCTION_2"]
-				contextHeaders := strings.Split(strings.ReplaceAll(strings.ReplaceAll(strings.ReplaceAll(desc.Value.String, "[", ""), "]", ""), "\"", ""), ",")
-
-				if err := d.Set("context_headers", contextHeaders); err != nil {
+				if err := d.Set("context_headers", parseContextHeaders(desc.Value.String)); err != nil {
 					return err
 				}
 			}
@@ -481,6 +483,10 @@ func ReadExternalFunction(d *schema.ResourceData, meta interface{}) error {
 			if err := d.Set("url_of_proxy_and_resource", desc.Value.String); err != nil {
 				return err
 			}
+		case "api_prefix":
+			if err := d.Set("api_prefix", desc.Value.String); err != nil {
+				return err
+			}
 		case "language":
 			// To ignore
 		default:
@@ -512,3 +518,18 @@ func DeleteExternalFunction(d *schema.ResourceData, meta interface{}) error {
 	d.SetId("")
 	return nil
 }
+
+// parseContextHeaders parses the context_headers property of DESCRIBE FUNCTION, which is formatted
+// as ["CONTEXT_FUNCTION_1","CONTEXT_FUNCTION_2"].
+func parseContextHeaders(s string) []string {
+	s = strings.TrimSpace(s)
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "["), "]")
+	contextHeaders := []string{}
+	for _, h := range strings.Split(s, ",") {
+		h = strings.Trim(strings.TrimSpace(h), `"`)
+		if h != "" {
+			contextHeaders = append(contextHeaders, h)
+		}
+	}
+	return contextHeaders
+}
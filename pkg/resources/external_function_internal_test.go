@@ -0,0 +1,14 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseContextHeaders(t *testing.T) {
+	r := require.New(t)
+	r.Equal([]string{"CURRENT_TIMESTAMP"}, parseContextHeaders(`["CURRENT_TIMESTAMP"]`))
+	r.Equal([]string{"CURRENT_ACCOUNT", "CURRENT_ROLE"}, parseContextHeaders(`["CURRENT_ACCOUNT", "CURRENT_ROLE"]`))
+	r.Empty(parseContextHeaders(`[]`))
+}
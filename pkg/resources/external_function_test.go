@@ -57,7 +57,8 @@ func expectExternalFunctionRead(mock sqlmock.Sqlmock) {
 		AddRow("headers", "{\"x-custom-header\":\"snowflake\"").
 		AddRow("context_headers", "[\"CURRENT_TIMESTAMP\"]").
 		AddRow("max_batch_rows", "not set").
-		AddRow("compression", "AUTO")
+		AddRow("compression", "AUTO").
+		AddRow("api_prefix", "https://123456.execute-api.us-west-2.amazonaws.com/prod/")
 
 	mock.ExpectQuery(`DESCRIBE FUNCTION "database_name"."schema_name"."my_test_function" \(varchar\)`).WillReturnRows(describeRows)
 }
@@ -92,6 +93,7 @@ func TestExternalFunctionRead(t *testing.T) {
 		r.Equal("my_test_function", d.Get("name").(string))
 		r.Equal("mock comment", d.Get("comment").(string))
 		r.Equal("VARCHAR", d.Get("return_type").(string))
+		r.Equal("https://123456.execute-api.us-west-2.amazonaws.com/prod/", d.Get("api_prefix").(string))
 
 		args := d.Get("arg").([]interface{})
 		r.Len(args, 1)
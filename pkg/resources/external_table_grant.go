@@ -7,15 +7,12 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validExternalTablePrivileges = NewPrivilegeSet(
-	privilegeOwnership,
-	privilegeReferences,
-	privilegeSelect,
-)
+var validExternalTablePrivileges = privilegesFor("EXTERNAL TABLE")
 
 var externalTableGrantSchema = map[string]*schema.Schema{
 	"database_name": {
@@ -31,6 +28,10 @@ var externalTableGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 	"external_table_name": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -44,6 +45,7 @@ var externalTableGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"revoke_existing_on_delete": revokeExistingOnDeleteSchema,
 	"privilege": {
 		Type:         schema.TypeString,
 		Optional:     true,
@@ -66,7 +68,7 @@ var externalTableGrantSchema = map[string]*schema.Schema{
 	},
 	"shares": {
 		Type:        schema.TypeSet,
-		Elem:        &schema.Schema{Type: schema.TypeString},
+		Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: snowflakeValidation.ValidateShareName},
 		Optional:    true,
 		Description: "Grants privilege to these shares (only valid if on_future is false).",
 	},
@@ -88,9 +90,12 @@ func ExternalTableGrant() *TerraformGrantResource {
 			Delete: DeleteExternalTableGrant,
 			Update: UpdateExternalTableGrant,
 
-			Schema: externalTableGrantSchema,
+			Schema:        externalTableGrantSchema,
+			CustomizeDiff: validateBulkGrantSupport("EXTERNAL TABLE"),
 			Importer: &schema.ResourceImporter{
-				StateContext: schema.ImportStatePassthroughContext,
+				StateContext: importFutureGrantWildcard(snowflake.FutureExternalTableGrant, func(g *futureGrantImport) string {
+					return NewExternalTableGrantID(g.DatabaseName, g.SchemaName, "", g.Privilege, g.Roles, []string{}, g.WithGrantOption).String()
+				}),
 			},
 		},
 		ValidPrivs: validExternalTablePrivileges,
@@ -120,6 +125,9 @@ func CreateExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
 	if (schemaName == "") && !onFuture {
 		return errors.New("schema_name must be set unless on_future is true")
 	}
+	if len(shares) > 0 && onFuture {
+		return errors.New("shares must be empty if on_future is true")
+	}
 
 	var builder snowflake.GrantBuilder
 	if onFuture {
@@ -247,14 +255,14 @@ func UpdateExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, rolesToRevoke, sharesToRevoke,
+		meta, d.Get("as_role").(string), builder, grantID.Privilege, rolesToRevoke, sharesToRevoke,
 	); err != nil {
 		return err
 	}
 	// then add
 
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, sharesToAdd,
+		meta, d.Get("as_role").(string), d.Get("current_grants").(string), builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, sharesToAdd,
 	); err != nil {
 		return err
 	}
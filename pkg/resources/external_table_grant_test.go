@@ -45,6 +45,50 @@ func TestExternalTableGrantCreate(t *testing.T) {
 	})
 }
 
+func TestExternalTableGrantDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := externalTableGrant(t, "test-db❄️PUBLIC❄️test-external-table❄️REFERENCES❄️false❄️test-role-1❄️test-share-1", map[string]interface{}{
+		"external_table_name": "test-external-table",
+		"schema_name":         "PUBLIC",
+		"database_name":       "test-db",
+		"privilege":           "REFERENCES",
+		"roles":               []interface{}{"test-role-1"},
+		"shares":              []interface{}{"test-share-1"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`^REVOKE REFERENCES ON EXTERNAL TABLE "test-db"."PUBLIC"."test-external-table" FROM ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectExec(`^REVOKE REFERENCES ON EXTERNAL TABLE "test-db"."PUBLIC"."test-external-table" FROM SHARE "test-share-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		err := resources.DeleteExternalTableGrant(d, db)
+		r.NoError(err)
+		r.Equal("", d.Id())
+	})
+}
+
+func TestFutureExternalTableGrantCreateRejectsShares(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"on_future":     true,
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+		"shares":        []interface{}{"test-share-1"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.ExternalTableGrant().Resource.Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		err := resources.CreateExternalTableGrant(d, db)
+		r.EqualError(err, "shares must be empty if on_future is true")
+	})
+}
+
 func TestExternalTableGrantRead(t *testing.T) {
 	r := require.New(t)
 
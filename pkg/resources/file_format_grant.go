@@ -11,10 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validFileFormatPrivileges = NewPrivilegeSet(
-	privilegeOwnership,
-	privilegeUsage,
-)
+var validFileFormatPrivileges = privilegesFor("FILE FORMAT")
 
 var fileFormatGrantSchema = map[string]*schema.Schema{
 	"database_name": {
@@ -30,6 +27,10 @@ var fileFormatGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 	"file_format_name": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -43,6 +44,7 @@ var fileFormatGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"revoke_existing_on_delete": revokeExistingOnDeleteSchema,
 	"privilege": {
 		Type:         schema.TypeString,
 		Optional:     true,
@@ -81,9 +83,12 @@ func FileFormatGrant() *TerraformGrantResource {
 			Delete: DeleteFileFormatGrant,
 			Update: UpdateFileFormatGrant,
 
-			Schema: fileFormatGrantSchema,
+			Schema:        fileFormatGrantSchema,
+			CustomizeDiff: validateBulkGrantSupport("FILE FORMAT"),
 			Importer: &schema.ResourceImporter{
-				StateContext: schema.ImportStatePassthroughContext,
+				StateContext: importFutureGrantWildcard(snowflake.FutureFileFormatGrant, func(g *futureGrantImport) string {
+					return NewFileFormatGrantID(g.DatabaseName, g.SchemaName, "", g.Privilege, g.Roles, g.WithGrantOption).String()
+				}),
 			},
 		},
 		ValidPrivs: validFileFormatPrivileges,
@@ -222,13 +227,13 @@ func UpdateFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, rolesToRevoke, []string{},
+		meta, d.Get("as_role").(string), builder, grantID.Privilege, rolesToRevoke, []string{},
 	); err != nil {
 		return err
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
+		meta, d.Get("as_role").(string), d.Get("current_grants").(string), builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
 	); err != nil {
 		return err
 	}
@@ -1,9 +1,13 @@
 package resources_test
 
 import (
+	"database/sql"
 	"testing"
 
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/require"
 )
 
@@ -62,3 +66,33 @@ func TestParseFunctionGrantOldIDWithArgs(t *testing.T) {
 	r.Equal(0, len(grantID.Shares))
 	r.Equal(false, grantID.WithGrantOption)
 }
+
+func TestParseFunctionGrantIDWithPrecisionArgs(t *testing.T) {
+	r := require.New(t)
+	grantID, err := resources.ParseFunctionGrantID("MY_DATABASE❄️MY_SCHEMA❄️MY_FUNCTION❄️NUMBER(38,0),VARCHAR❄️USAGE❄️false❄️role1❄️")
+	r.NoError(err)
+	r.Equal([]string{"NUMBER(38,0)", "VARCHAR"}, grantID.ArgumentDataTypes)
+
+	grantID, err = resources.ParseFunctionGrantID("MY_DATABASE|MY_SCHEMA|MY_FUNCTION(NUMBER(38,0), VARCHAR)|USAGE|role1|false")
+	r.NoError(err)
+	r.Equal("MY_FUNCTION", grantID.ObjectName)
+	r.Equal([]string{"NUMBER(38,0)", "VARCHAR"}, grantID.ArgumentDataTypes)
+}
+
+func TestFutureFunctionGrantCreateRejectsArguments(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"on_future":           true,
+		"argument_data_types": []interface{}{"STRING"},
+		"database_name":       "test-db",
+		"privilege":           "USAGE",
+		"roles":               []interface{}{"test-role-1"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.FunctionGrant().Resource.Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		err := resources.CreateFunctionGrant(d, db)
+		r.EqualError(err, "argument_data_types and arguments must be empty if on_future is true")
+	})
+}
@@ -1,15 +1,21 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/jmoiron/sqlx"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/snowflakedb/gosnowflake"
 )
 
@@ -33,6 +39,47 @@ const (
 	grantIDDelimiter = '|'
 )
 
+// asRoleSchema is shared by the grant resources built on the generic grant helpers.
+var asRoleSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Optional:    true,
+	Description: "The role to switch to (USE ROLE) when granting and revoking, e.g. SECURITYADMIN, instead of the provider's role. The provider's credentials are reused and grants are still read with the provider's role.",
+}
+
+// currentGrantsSchema is shared by the grant resources built on the generic grant helpers.
+var currentGrantsSchema = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	Default:      "COPY",
+	ForceNew:     true,
+	Description:  "Only used when privilege is OWNERSHIP. Specifies whether the existing outbound privileges on the object are kept (COPY) or removed (REVOKE) when ownership is transferred. With REVOKE, the privileges managed by other grant resources on the same object are removed as well and show up as drift on their next refresh.",
+	ValidateFunc: validation.StringInSlice([]string{"COPY", "REVOKE"}, true),
+}
+
+// createdOnSchema is shared by the grant resources built on the generic grant helpers.
+var createdOnSchema = &schema.Schema{
+	Type:        schema.TypeMap,
+	Computed:    true,
+	Elem:        &schema.Schema{Type: schema.TypeString},
+	Description: "The time (RFC 3339) at which the privilege was granted to each of the roles, keyed by role name, as reported by SHOW GRANTS.",
+}
+
+// grantedBySchema is shared by the grant resources built on the generic grant helpers.
+var grantedBySchema = &schema.Schema{
+	Type:        schema.TypeMap,
+	Computed:    true,
+	Elem:        &schema.Schema{Type: schema.TypeString},
+	Description: "The role that granted the privilege to each of the roles, keyed by role name, as reported by SHOW GRANTS. Not reported for future grants.",
+}
+
+// revokeExistingOnDeleteSchema is shared by the grant resources that support on_future.
+var revokeExistingOnDeleteSchema = &schema.Schema{
+	Type:        schema.TypeBool,
+	Optional:    true,
+	Default:     false,
+	Description: "Destroying a future grant only revokes the privilege on future objects; objects that already received it through the future grant keep it. When this is set to true and on_future is true, destroying the resource also revokes the privilege on all existing objects in the database or schema (REVOKE ... ON ALL ...), including ones granted outside of the future grant.",
+}
+
 // currentGrant represents a generic grant of a privilege from a grant (the target) to a
 // grantee. This type can be used in conjunction with github.com/jmoiron/sqlx to
 // build a nice go representation of a grant.
@@ -48,13 +95,17 @@ type currentGrant struct {
 }
 
 // futureGrant represents the columns in the response from `SHOW FUTURE GRANTS
-// IN SCHEMA...` and can be used in conjunction with sqlx.
+// IN SCHEMA...` and can be used in conjunction with sqlx. Unlike SHOW GRANTS, the object type and
+// grantee type columns are named grant_on and grant_to; the granted_on and granted_to spellings
+// are accepted as well so that a change in the output does not silently empty the grants read.
 type futureGrant struct {
 	CreatedOn   time.Time `db:"created_on"`
 	Privilege   string    `db:"privilege"`
 	GrantType   string    `db:"grant_on"`
+	GrantedOn   string    `db:"granted_on"`
 	GrantName   string    `db:"name"`
 	GranteeType string    `db:"grant_to"`
+	GrantedTo   string    `db:"granted_to"`
 	GranteeName string    `db:"grantee_name"`
 	GrantOption bool      `db:"grant_option"`
 }
@@ -69,32 +120,194 @@ type grant struct {
 	GranteeType string
 	GranteeName string
 	GrantOption bool
+	GrantedBy   string
+}
+
+// grantCacheKey identifies the result of a SHOW GRANTS statement on a given connection.
+type grantCacheKey struct {
+	db   *sql.DB
+	stmt string
+}
+
+// grantCache holds the grants read for each object for the lifetime of the provider process, which
+// Terraform restarts for every plan and apply. It keeps the refresh of many grant resources on the
+// same object, e.g. before a large destroy, from running the same SHOW GRANTS over and over. Every
+// grant and revoke goes through changeGrants, which drops the whole cache: grants on all objects of
+// a database or schema and ownership transfers change the grants of more than the object named.
+type grantCache struct {
+	mu sync.Mutex
+	// generation is bumped by every invalidation, so that a read that was in flight meanwhile does
+	// not cache what it read before the grant or revoke.
+	generation uint64
+	entries    map[grantCacheKey][]*grant
+}
+
+var grantReadCache = &grantCache{entries: map[grantCacheKey][]*grant{}}
+
+// get returns the grants cached for key, if any, and the current generation to pass to set.
+func (c *grantCache) get(key grantCacheKey) ([]*grant, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	grants, ok := c.entries[key]
+	return grants, c.generation, ok
+}
+
+// set caches grants for key unless the cache was invalidated since get returned generation.
+func (c *grantCache) set(key grantCacheKey, grants []*grant, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation != generation {
+		return
+	}
+	c.entries[key] = grants
+}
+
+func (c *grantCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	c.entries = map[grantCacheKey][]*grant{}
+}
+
+// grantLocks serializes the grants and revokes on each object for the connections registered with
+// SerializeGrantsPerObject. Terraform applies independent resources in parallel, so without it
+// several grant resources on the same object can interleave their GRANT and REVOKE statements and
+// fail on Snowflake's object locks.
+type grantLocks struct {
+	mu      sync.Mutex
+	enabled map[*sql.DB]bool
+	objects map[grantCacheKey]*sync.Mutex
+}
+
+var grantObjectLocks = &grantLocks{enabled: map[*sql.DB]bool{}, objects: map[grantCacheKey]*sync.Mutex{}}
+
+// SerializeGrantsPerObject makes the grant resources using db run their grants and revokes on the
+// same object one at a time.
+func SerializeGrantsPerObject(db *sql.DB) {
+	grantObjectLocks.mu.Lock()
+	defer grantObjectLocks.mu.Unlock()
+	grantObjectLocks.enabled[db] = true
+}
+
+// lock blocks until no other grant operation runs on the object built by builder and returns the
+// function releasing it. It does nothing unless db was registered with SerializeGrantsPerObject.
+func (l *grantLocks) lock(db *sql.DB, builder snowflake.GrantBuilder) func() {
+	l.mu.Lock()
+	if !l.enabled[db] {
+		l.mu.Unlock()
+		return func() {}
+	}
+	// SHOW GRANTS ON <object> is unique per object, like the grant cache key
+	key := grantCacheKey{db: db, stmt: builder.Show()}
+	object, ok := l.objects[key]
+	if !ok {
+		object = &sync.Mutex{}
+		l.objects[key] = object
+	}
+	l.mu.Unlock()
+
+	object.Lock()
+	return object.Unlock
+}
+
+// changeGrants runs change, which grants or revokes privileges on the object built by builder,
+// holding the lock on the object and invalidating the grant cache once it is done. All grants and
+// revokes of the grant resources go through it.
+func changeGrants(db *sql.DB, builder snowflake.GrantBuilder, change func() error) error {
+	defer grantObjectLocks.lock(db, builder)()
+	defer grantReadCache.invalidate()
+	return change()
+}
+
+// queryGrants reads the current or future grants on the object built by builder.
+func queryGrants(db *sql.DB, builder snowflake.GrantBuilder, futureObjects bool) ([]*grant, error) {
+	if futureObjects {
+		return readGenericFutureGrants(db, builder)
+	}
+	return readGenericCurrentGrants(db, builder)
+}
+
+// cachedGrants is queryGrants served from grantReadCache when possible.
+func cachedGrants(db *sql.DB, builder snowflake.GrantBuilder, futureObjects bool) ([]*grant, error) {
+	key := grantCacheKey{db: db, stmt: builder.Show()}
+	grants, generation, ok := grantReadCache.get(key)
+	if ok {
+		log.Printf("[DEBUG] using cached result of %v", key.stmt)
+		return grants, nil
+	}
+	grants, err := queryGrants(db, builder, futureObjects)
+	if err != nil {
+		return nil, err
+	}
+	grantReadCache.set(key, grants, generation)
+	return grants, nil
 }
 
 // createGenericGrantRolesAndShares will create generic grants for a set of roles and shares.
+// If asRole is set, the grants are issued as that role instead of the provider's. currentGrants
+// (COPY or REVOKE) is only used when transferring OWNERSHIP to a role.
 func createGenericGrantRolesAndShares(
 	meta interface{},
+	asRole string,
+	currentGrants string,
 	builder snowflake.GrantBuilder,
 	priv string,
 	grantOption bool,
 	roles []string,
 	shares []string,
 ) error {
+	if err := checkShareGrantees(builder, shares); err != nil {
+		return err
+	}
 	db := meta.(*sql.DB)
-	for _, role := range roles {
-		if err := snowflake.Exec(db, builder.Role(role).Grant(priv, grantOption)); err != nil {
-			return err
+	return changeGrants(db, builder, func() error {
+		if asRole != "" {
+			stmts := []string{}
+			for _, role := range roles {
+				stmts = append(stmts, roleGrantStatement(builder, role, priv, grantOption, currentGrants))
+			}
+			for _, share := range shares {
+				stmts = append(stmts, builder.Share(share).Grant(priv, grantOption))
+			}
+			return snowflake.ExecAsRole(db, asRole, stmts)
+		}
+
+		for _, role := range roles {
+			if err := snowflake.Exec(db, roleGrantStatement(builder, role, priv, grantOption, currentGrants)); err != nil {
+				return err
+			}
 		}
-	}
 
+		for _, share := range shares {
+			if err := snowflake.Exec(db, builder.Share(share).Grant(priv, grantOption)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// checkShareGrantees returns an error if shares are given to a builder that cannot grant to a
+// share, such as the builders of grants on future or all objects.
+func checkShareGrantees(builder snowflake.GrantBuilder, shares []string) error {
 	for _, share := range shares {
-		if err := snowflake.Exec(db, builder.Share(share).Grant(priv, grantOption)); err != nil {
-			return err
+		if builder.Share(share) == nil {
+			return fmt.Errorf("privileges on future or all objects cannot be granted to share %v", share)
 		}
 	}
 	return nil
 }
 
+// roleGrantStatement returns the statement granting priv to role. Ownership transfers copy the
+// current grants unless currentGrants is REVOKE.
+func roleGrantStatement(builder snowflake.GrantBuilder, role string, priv string, grantOption bool, currentGrants string) string {
+	ge := builder.Role(role)
+	if cge, ok := ge.(*snowflake.CurrentGrantExecutable); ok && priv == privilegeOwnership.String() && strings.EqualFold(currentGrants, "REVOKE") {
+		return cge.TransferOwnership(currentGrants)
+	}
+	return ge.Grant(priv, grantOption)
+}
+
 func createGenericGrant(d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder) error {
 	priv := d.Get("privilege").(string)
 	grantOption := d.Get("with_grant_option").(bool)
@@ -102,6 +315,8 @@ func createGenericGrant(d *schema.ResourceData, meta interface{}, builder snowfl
 
 	return createGenericGrantRolesAndShares(
 		meta,
+		d.Get("as_role").(string),
+		d.Get("current_grants").(string),
 		builder,
 		priv,
 		grantOption,
@@ -119,21 +334,13 @@ func readGenericGrant(
 	validPrivileges PrivilegeSet,
 ) error {
 	db := meta.(*sql.DB)
-	var grants []*grant
-	var err error
-	if futureObjects {
-		grants, err = readGenericFutureGrants(db, builder)
-	} else {
-		grants, err = readGenericCurrentGrants(db, builder)
-	}
+	grants, err := cachedGrants(db, builder, futureObjects)
 	if err != nil {
 		// HACK HACK: If the object doesn't exist or not authorized then we can assume someone deleted it
 		// We also check the error number matches
 		// We set the tf id == blank and return.
 		// I don't know of a better way to work around this issue
-		if snowflakeErr, ok := err.(*gosnowflake.SnowflakeError); ok && //nolint:errorlint // todo: should be fixed
-			snowflakeErr.Number == 2003 &&
-			strings.Contains(err.Error(), "does not exist or not authorized") {
+		if isObjectNotExistError(err) {
 			log.Printf("[WARN] resource (%s) not found, removing from state file", d.Id())
 			d.SetId("")
 			return nil
@@ -147,6 +354,8 @@ func readGenericGrant(
 	// Map of roles to privileges
 	rolePrivileges := map[string]PrivilegeSet{}
 	sharePrivileges := map[string]PrivilegeSet{}
+	// Map of roles to the grant of our privilege, for created_on and granted_by
+	roleGrants := map[string]*grant{}
 
 	// List of all grants for each schema_database
 	for _, grant := range grants {
@@ -162,6 +371,11 @@ func readGenericGrant(
 
 			if strings.ReplaceAll(builder.GrantType(), " ", "_") == grant.GrantType {
 				privileges.addString(grant.Privilege)
+				// ALL PRIVILEGES is reported as its individual privileges, take the first one
+				_, seen := roleGrants[roleName]
+				if grant.Privilege == priv || (priv == privilegeAllPrivileges.String() && !seen) {
+					roleGrants[roleName] = grant
+				}
 			}
 			// Reassign set back
 			rolePrivileges[roleName] = privileges
@@ -188,15 +402,28 @@ func readGenericGrant(
 	}
 	multipleGrantFeatureFlag := d.Get("enable_multiple_grants").(bool)
 	var roles, shares []string
+	createdOn, grantedBy := map[string]string{}, map[string]string{}
 	// Now see which roles have our privilege.
-	for roleName, privileges := range rolePrivileges {
-		if privileges.hasString(priv) {
+	for granteeName, privileges := range rolePrivileges {
+		roleName := matchGranteeName(granteeName, existingRoles, func(n string) bool {
+			_, ok := rolePrivileges[n]
+			return ok
+		})
+		if hasGrantedPrivilege(privileges, priv, validPrivileges) {
 			// CASE A: Whatever role we were already managing, continue to do so.
 			caseA := existingRoles.Contains(roleName)
 			// CASE B : If multiple grants is not enabled (meaning this is an authoritative resource) then we care about what roles have privilige unless on_future is enabled in which case we don't care (because we will get flooded with diffs)
 			caseB := !multipleGrantFeatureFlag && !futureObjects
 			if caseA || caseB {
 				roles = append(roles, roleName)
+				if g, ok := roleGrants[granteeName]; ok {
+					if !g.CreatedOn.IsZero() {
+						createdOn[roleName] = g.CreatedOn.Format(time.RFC3339)
+					}
+					if g.GrantedBy != "" {
+						grantedBy[roleName] = g.GrantedBy
+					}
+				}
 			}
 		}
 	}
@@ -207,7 +434,8 @@ func readGenericGrant(
 	}
 	// Now see which shares have our privilege.
 	for shareName, privileges := range sharePrivileges {
-		if privileges.hasString(priv) {
+		shareName = matchShareName(shareName, existingShares)
+		if hasGrantedPrivilege(privileges, priv, validPrivileges) {
 			// CASE A: Whatever share we were already managing, continue to do so.
 			caseA := existingShares.Contains(shareName)
 			// CASE B : If multiple grants is not enabled (meaning this is an authoritative resource) then we care about what shares have privilige unless on_future is enabled in which case we don't care (because we will get flooded with diffs)
@@ -225,6 +453,17 @@ func readGenericGrant(
 		return err
 	}
 
+	if _, ok := grantSchema["created_on"]; ok {
+		if err := d.Set("created_on", createdOn); err != nil {
+			return err
+		}
+	}
+	if _, ok := grantSchema["granted_by"]; ok {
+		if err := d.Set("granted_by", grantedBy); err != nil {
+			return err
+		}
+	}
+
 	_, sharesOk := grantSchema["shares"]
 	if sharesOk && !futureObjects {
 		if err := d.Set("shares", shares); err != nil {
@@ -238,6 +477,111 @@ func readGenericGrant(
 	return nil
 }
 
+// readAllGrant reads back a grant on all objects of a database or schema, which Snowflake does not
+// record as such: of the configured roles it keeps those holding the privilege on every object the
+// grant currently covers. A role missing it on an object created since the grant was made is
+// dropped from the state, so that the next apply grants on all objects again.
+func readAllGrant(d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder, validPrivileges PrivilegeSet) error {
+	db := meta.(*sql.DB)
+	allBuilder, ok := builder.(*snowflake.AllGrantBuilder)
+	if !ok {
+		return fmt.Errorf("%v does not grant on all objects", builder.Name())
+	}
+	objects, err := snowflake.ShowAllGrantObjects(db, allBuilder)
+	if err != nil {
+		if isObjectNotExistError(err) {
+			log.Printf("[WARN] resource (%s) not found, removing from state file", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	priv := d.Get("privilege").(string)
+	roles := []string{}
+	for _, role := range expandStringList(d.Get("roles").(*schema.Set).List()) {
+		granted, err := snowflake.ShowAllGrantPrivileges(db, allBuilder, role, objects)
+		if isObjectNotExistError(err) {
+			log.Printf("[WARN] role %v not found, removing it from %s", role, d.Id())
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		onAll := true
+		for _, object := range objects {
+			privileges := PrivilegeSet{}
+			for _, p := range granted[object] {
+				privileges.addString(p)
+			}
+			if !hasGrantedPrivilege(privileges, priv, validPrivileges) {
+				onAll = false
+				break
+			}
+		}
+		if onAll {
+			roles = append(roles, role)
+		}
+	}
+	return d.Set("roles", roles)
+}
+
+// hasGrantedPrivilege reports whether privileges, as read from SHOW GRANTS for a grantee, hold priv.
+// ALL PRIVILEGES is held when every privilege it expands to is.
+func hasGrantedPrivilege(privileges PrivilegeSet, priv string, validPrivileges PrivilegeSet) bool {
+	if priv == privilegeAllPrivileges.String() && privileges.grantedByAll(validPrivileges) {
+		return true
+	}
+	return privileges.hasString(priv)
+}
+
+// matchGranteeName returns the spelling of name already tracked in existing if name is how SHOW
+// GRANTS reports it, see sameGranteeName. Grantees are always quoted, so other differences in case
+// are different grantees. read reports whether a grantee was read with exactly the given name, in
+// which case no other name is matched to it.
+func matchGranteeName(name string, existing *schema.Set, read func(string) bool) string {
+	if existing.Contains(name) {
+		return name
+	}
+	for _, e := range existing.List() {
+		if sameGranteeName(e.(string), name) && !read(e.(string)) {
+			return e.(string)
+		}
+	}
+	return name
+}
+
+// unquotedGranteeName matches the grantee names Snowflake would resolve the same unquoted.
+var unquotedGranteeName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+
+// sameGranteeName reports whether name, as read from SHOW GRANTS, is the grantee configured as
+// configured. Besides an exact match, SHOW GRANTS reports a grantee created from an unquoted
+// identifier upper-cased, so without this a role configured as `myrole` would be read back as
+// `MYROLE` and show up as a perpetual diff.
+func sameGranteeName(configured, name string) bool {
+	return configured == name || (unquotedGranteeName.MatchString(configured) && strings.ToUpper(configured) == name)
+}
+
+// matchShareName returns the spelling of name already tracked in existing if the two only differ
+// in case. Share names read from SHOW GRANTS also have the providing account stripped (see StripAccountFromName), so a share configured with an
+// account-qualified name is matched on its unqualified part as well.
+func matchShareName(name string, existing *schema.Set) string {
+	if existing.Contains(name) {
+		return name
+	}
+	for _, e := range existing.List() {
+		if strings.EqualFold(e.(string), name) {
+			return e.(string)
+		}
+	}
+	for _, e := range existing.List() {
+		if strings.EqualFold(StripAccountFromName(e.(string)), StripAccountFromName(name)) {
+			return e.(string)
+		}
+	}
+	return name
+}
+
 func readGenericCurrentGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
 	stmt := builder.Show()
 	rows, err := snowflake.Query(db, stmt)
@@ -267,6 +611,7 @@ func readGenericCurrentGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*gr
 			GranteeType: currentGrant.GranteeType,
 			GranteeName: currentGrant.GranteeName,
 			GrantOption: currentGrant.GrantOption,
+			GrantedBy:   currentGrant.GrantedBy,
 		}
 		grants = append(grants, grant)
 	}
@@ -275,10 +620,8 @@ func readGenericCurrentGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*gr
 }
 
 func readGenericFutureGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
-	conn := sqlx.NewDb(db, "snowflake")
-
 	stmt := builder.Show()
-	rows, err := conn.Queryx(stmt)
+	rows, err := snowflake.Query(db, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -290,12 +633,19 @@ func readGenericFutureGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*gra
 		if err := rows.StructScan(futureGrant); err != nil {
 			return nil, err
 		}
+		grantType, granteeType := futureGrant.GrantType, futureGrant.GranteeType
+		if grantType == "" {
+			grantType = futureGrant.GrantedOn
+		}
+		if granteeType == "" {
+			granteeType = futureGrant.GrantedTo
+		}
 		grant := &grant{
 			CreatedOn:   futureGrant.CreatedOn,
 			Privilege:   futureGrant.Privilege,
-			GrantType:   futureGrant.GrantType,
+			GrantType:   grantType,
 			GrantName:   futureGrant.GrantName,
-			GranteeType: futureGrant.GranteeType,
+			GranteeType: granteeType,
 			GranteeName: futureGrant.GranteeName,
 			GrantOption: futureGrant.GrantOption,
 		}
@@ -305,38 +655,232 @@ func readGenericFutureGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*gra
 	return grants, nil
 }
 
+// futureGrantImport is a future grant expanded from a wildcard import ID.
+type futureGrantImport struct {
+	DatabaseName    string
+	SchemaName      string
+	Privilege       string
+	Roles           []string
+	WithGrantOption bool
+}
+
+// importFutureGrantWildcard returns an importer that accepts, next to the resource's own IDs, a
+// wildcard ID of the form database|schema|*|privilege|future (schema is empty for future grants in
+// the database). The wildcard is expanded by reading SHOW FUTURE GRANTS into the roles holding the
+// privilege and replaced by the ID built by newID, so users don't have to know the ID format.
+func importFutureGrantWildcard(
+	futureBuilder func(db, schema string) snowflake.GrantBuilder,
+	newID func(g *futureGrantImport) string,
+) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		parts := strings.Split(d.Id(), "|")
+		if len(parts) != 5 || parts[2] != "*" || !strings.EqualFold(parts[4], "future") {
+			return []*schema.ResourceData{d}, nil
+		}
+		g, err := expandFutureGrantWildcard(meta.(*sql.DB), futureBuilder(parts[0], parts[1]), parts[0], parts[1], parts[3])
+		if err != nil {
+			return nil, fmt.Errorf("unable to import %v: %w", d.Id(), err)
+		}
+		d.SetId(newID(g))
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// expandFutureGrantWildcard finds the roles holding privilege through the future grants of builder.
+// All of them have to agree on the grant option since a resource manages a single one.
+func expandFutureGrantWildcard(db *sql.DB, builder snowflake.GrantBuilder, databaseName, schemaName, privilege string) (*futureGrantImport, error) {
+	grants, err := queryGrants(db, builder, true)
+	if err != nil {
+		return nil, err
+	}
+
+	privilege = strings.ToUpper(privilege)
+	grantType := strings.ReplaceAll(builder.GrantType(), " ", "_")
+	rolesByGrantOption := map[bool][]string{}
+	for _, grant := range grants {
+		if grant.GranteeType != "ROLE" || grant.GrantType != grantType || grant.Privilege != privilege {
+			continue
+		}
+		rolesByGrantOption[grant.GrantOption] = append(rolesByGrantOption[grant.GrantOption], grant.GranteeName)
+	}
+
+	switch len(rolesByGrantOption) {
+	case 0:
+		return nil, fmt.Errorf("no future %v grants of %v found with %v", builder.GrantType(), privilege, builder.Show())
+	case 1:
+	default:
+		return nil, fmt.Errorf("ambiguous future %v grants of %v: roles %v have it with grant option and roles %v without, import them separately with their full IDs",
+			builder.GrantType(), privilege, rolesByGrantOption[true], rolesByGrantOption[false])
+	}
+
+	g := &futureGrantImport{
+		DatabaseName: databaseName,
+		SchemaName:   schemaName,
+		Privilege:    privilege,
+	}
+	for withGrantOption, roles := range rolesByGrantOption {
+		sort.Strings(roles)
+		g.Roles = roles
+		g.WithGrantOption = withGrantOption
+	}
+	return g, nil
+}
+
 // Deletes specific roles and shares from a grant
 // Does not modify TF remote state.
+// If asRole is set, the grants are revoked as that role instead of the provider's.
+// A failed revoke does not stop the remaining ones; their errors are returned together as revokeErrors.
 func deleteGenericGrantRolesAndShares(
 	meta interface{},
+	asRole string,
 	builder snowflake.GrantBuilder,
 	priv string,
 	roles []string,
 	shares []string,
 ) error {
+	if err := checkShareGrantees(builder, shares); err != nil {
+		return err
+	}
 	db := meta.(*sql.DB)
+	return changeGrants(db, builder, func() error {
+		var errs revokeErrors
+		if asRole != "" {
+			stmts := []string{}
+			for _, role := range roles {
+				stmts = append(stmts, builder.Role(role).Revoke(priv)...)
+			}
+			for _, share := range shares {
+				stmts = append(stmts, builder.Share(share).Revoke(priv)...)
+			}
+			errs = snowflake.ExecEachAsRole(db, asRole, stmts)
+		} else {
+			for _, role := range roles {
+				if err := snowflake.ExecMulti(db, builder.Role(role).Revoke(priv)); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			for _, share := range shares {
+				if err := snowflake.ExecMulti(db, builder.Share(share).Revoke(priv)); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+		if len(errs) > 0 {
+			return errs
+		}
+		return nil
+	})
+}
 
-	for _, role := range roles {
-		if err := snowflake.ExecMulti(db, builder.Role(role).Revoke(priv)); err != nil {
+// revokeErrors are the errors of the revokes that failed in deleteGenericGrantRolesAndShares.
+type revokeErrors []error
+
+func (e revokeErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func deleteGenericGrant(d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder) error {
+	priv := d.Get("privilege").(string)
+	roles, shares := expandRolesAndShares(d)
+	asRole := d.Get("as_role").(string)
+	if err := deleteGenericGrantRolesAndShares(meta, asRole, builder, priv, roles, shares); err != nil {
+		// Error 2003 is also returned when a grantee or as_role was dropped or is not visible to
+		// us, so it only means the grant is gone if the object itself no longer exists.
+		var errs revokeErrors
+		if !errors.As(err, &errs) || !allObjectNotExistErrors(errs) {
 			return err
 		}
+		exists, existsErr := grantObjectExists(meta.(*sql.DB), builder)
+		if existsErr != nil {
+			return fmt.Errorf("%w; unable to check whether %v still exists: %v", err, builder.Name(), existsErr)
+		}
+		if exists {
+			return err
+		}
+		// The object, and with it its grants, was dropped outside of Terraform.
+		log.Printf("[WARN] unable to revoke %v on %v, it no longer exists; removing grant (%s) from state: %v", priv, builder.Name(), d.Id(), err)
+		d.SetId("")
+		return nil
 	}
-
-	for _, share := range shares {
-		if err := snowflake.ExecMulti(db, builder.Share(share).Revoke(priv)); err != nil {
+	if _, ok := builder.(*snowflake.FutureGrantBuilder); ok && d.Get("revoke_existing_on_delete").(bool) {
+		if err := revokeExistingGrants(meta, asRole, builder, priv, roles); err != nil {
 			return err
 		}
 	}
+	d.SetId("")
 	return nil
 }
 
-func deleteGenericGrant(d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder) error {
-	priv := d.Get("privilege").(string)
-	roles, shares := expandRolesAndShares(d)
-	if err := deleteGenericGrantRolesAndShares(meta, builder, priv, roles, shares); err != nil {
-		return err
+// isObjectNotExistError reports whether err is Snowflake error 2003, returned for statements on an
+// object that does not exist or is not visible to the current role.
+func isObjectNotExistError(err error) bool {
+	var snowflakeErr *gosnowflake.SnowflakeError
+	return errors.As(err, &snowflakeErr) &&
+		snowflakeErr.Number == 2003 &&
+		strings.Contains(err.Error(), "does not exist or not authorized")
+}
+
+func allObjectNotExistErrors(errs []error) bool {
+	for _, err := range errs {
+		if !isObjectNotExistError(err) {
+			return false
+		}
+	}
+	return true
+}
+
+// grantObjectExists reports whether the object the grants of builder are on can still be shown.
+func grantObjectExists(db *sql.DB, builder snowflake.GrantBuilder) (bool, error) {
+	rows, err := snowflake.Query(db, builder.Show())
+	if isObjectNotExistError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, rows.Close()
+}
+
+// revokeExistingGrants revokes priv from roles on all existing objects covered by the future grant builder.
+func revokeExistingGrants(meta interface{}, asRole string, builder snowflake.GrantBuilder, priv string, roles []string) error {
+	db := meta.(*sql.DB)
+	stmts := []string{}
+	for _, role := range roles {
+		ge, ok := builder.Role(role).(*snowflake.FutureGrantExecutable)
+		if !ok {
+			return fmt.Errorf("cannot revoke existing grants for a %T", builder)
+		}
+		stmts = append(stmts, ge.RevokeExisting(priv))
+	}
+	return changeGrants(db, builder, func() error {
+		if asRole != "" {
+			return snowflake.ExecAsRole(db, asRole, stmts)
+		}
+		for _, stmt := range stmts {
+			if err := snowflake.Exec(db, stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// seedGrantees sets roles, and shares unless nil, from a grant ID when the state has none, as
+// after an import. readGenericGrant only keeps the grantees already in the state when
+// enable_multiple_grants is set, so without this an imported grant would read back no grantees.
+func seedGrantees(d *schema.ResourceData, roles []string, shares []string) error {
+	if _, ok := d.GetOk("roles"); !ok {
+		if err := d.Set("roles", roles); err != nil {
+			return err
+		}
+	}
+	if _, ok := d.GetOk("shares"); !ok && shares != nil {
+		return d.Set("shares", shares)
 	}
-	d.SetId("")
 	return nil
 }
 
@@ -352,6 +896,68 @@ func expandRolesAndShares(d *schema.ResourceData) ([]string, []string) {
 	return roles, shares
 }
 
+// splitArgumentDataTypes splits the comma-separated argument data types of a function or procedure
+// grant ID. The commas within parentheses, e.g. NUMBER(38,0), belong to a type.
+func splitArgumentDataTypes(s string) []string {
+	var types []string
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				if t := strings.TrimSpace(s[start:i]); t != "" {
+					types = append(types, t)
+				}
+				start = i + 1
+			}
+		}
+	}
+	if t := strings.TrimSpace(s[start:]); t != "" {
+		types = append(types, t)
+	}
+	return types
+}
+
+// grantIDSeparatorEscape replaces the ❄️ separator of grant IDs within the names they encode.
+const grantIDSeparatorEscape = "__SNOWFLAKE__"
+
+// escapeGrantIDPart escapes the ❄️ separator in a name encoded in a grant ID, so that the ID can be
+// split back into its parts. A name containing the escape sequence itself does not round-trip.
+func escapeGrantIDPart(s string) string {
+	return strings.ReplaceAll(s, "❄️", grantIDSeparatorEscape)
+}
+
+// unescapeGrantIDPart reverses escapeGrantIDPart.
+func unescapeGrantIDPart(s string) string {
+	return strings.ReplaceAll(s, grantIDSeparatorEscape, "❄️")
+}
+
+// grantIDListSeparatorEscape replaces the comma separating the names of a list within a grant ID.
+const grantIDListSeparatorEscape = "__COMMA__"
+
+// escapeGrantIDList escapes each of names with escapeGrantIDPart, escapes their commas and joins
+// them with commas.
+func escapeGrantIDList(names []string) string {
+	escaped := make([]string, 0, len(names))
+	for _, name := range names {
+		escaped = append(escaped, strings.ReplaceAll(escapeGrantIDPart(name), ",", grantIDListSeparatorEscape))
+	}
+	return strings.Join(escaped, ",")
+}
+
+// unescapeGrantIDList splits a comma-separated list written by escapeGrantIDList.
+func unescapeGrantIDList(s string) []string {
+	names := helpers.SplitStringToSlice(s, ",")
+	for i, name := range names {
+		names[i] = unescapeGrantIDPart(strings.ReplaceAll(name, grantIDListSeparatorEscape, ","))
+	}
+	return names
+}
+
 // changeDiff calculates roles/shares to add/revoke.
 func changeDiff(d *schema.ResourceData, key string) (toAdd []string, toRemove []string) {
 	o, n := d.GetChange(key)
@@ -361,3 +967,24 @@ func changeDiff(d *schema.ResourceData, key string) (toAdd []string, toRemove []
 	toRemove = expandStringList(oldSet.Difference(newSet).List())
 	return
 }
+
+// validateBulkGrantSupport returns a CustomizeDiffFunc that rejects on_future and on_all at plan
+// time when Snowflake cannot grant on future or all objects of objectType, instead of letting the
+// generated GRANT fail on apply.
+func validateBulkGrantSupport(objectType string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		onFuture, _ := d.Get("on_future").(bool)
+		onAll, _ := d.Get("on_all").(bool)
+		return checkBulkGrantSupport(objectType, onFuture, onAll)
+	}
+}
+
+func checkBulkGrantSupport(objectType string, onFuture, onAll bool) error {
+	if onFuture && !helpers.SupportsFutureGrants(objectType) {
+		return fmt.Errorf("on_future is not supported for %v grants, Snowflake cannot grant privileges on future %v objects", objectType, objectType)
+	}
+	if onAll && !helpers.SupportsAllGrants(objectType) {
+		return fmt.Errorf("on_all is not supported for %v grants, Snowflake cannot grant privileges on all %v objects", objectType, objectType)
+	}
+	return nil
+}
@@ -0,0 +1,276 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func viewGrantRows(n int) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{
+		"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+	})
+	for i := 0; i < n; i++ {
+		rows.AddRow(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "SELECT", "VIEW", "test-view", "ROLE", fmt.Sprintf("role-%d", i), false, "bob")
+	}
+	return rows
+}
+
+func TestCachedGrants(t *testing.T) {
+	r := require.New(t)
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	builder := snowflake.ViewGrant("test-db", "PUBLIC", "test-view")
+	mock.ExpectQuery(`^SHOW GRANTS ON VIEW "test-db"."PUBLIC"."test-view"$`).WillReturnRows(viewGrantRows(2))
+
+	// The second read is served from the cache.
+	for i := 0; i < 2; i++ {
+		grants, err := cachedGrants(db, builder, false)
+		r.NoError(err)
+		r.Len(grants, 2)
+	}
+	r.NoError(mock.ExpectationsWereMet())
+
+	// Revoking drops the cached entry, so the next read goes to Snowflake again.
+	mock.ExpectBegin()
+	mock.ExpectExec(`^REVOKE SELECT ON VIEW "test-db"."PUBLIC"."test-view" FROM ROLE "role-0"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	r.NoError(deleteGenericGrantRolesAndShares(db, "", builder, "SELECT", []string{"role-0"}, nil))
+
+	mock.ExpectQuery(`^SHOW GRANTS ON VIEW "test-db"."PUBLIC"."test-view"$`).WillReturnRows(viewGrantRows(1))
+	grants, err := cachedGrants(db, builder, false)
+	r.NoError(err)
+	r.Len(grants, 1)
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestCachedGrantsReadDuringRevoke(t *testing.T) {
+	r := require.New(t)
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+	mock.MatchExpectationsInOrder(false)
+
+	// A read that is still running while a revoke completes must not cache what it read.
+	builder := snowflake.ViewGrant("test-db", "PUBLIC", "test-view")
+	mock.ExpectQuery(`^SHOW GRANTS ON VIEW "test-db"."PUBLIC"."test-view"$`).WillReturnRows(viewGrantRows(2)).WillDelayFor(200 * time.Millisecond)
+	mock.ExpectBegin()
+	mock.ExpectExec(`^REVOKE SELECT ON VIEW "test-db"."PUBLIC"."test-view" FROM ROLE "role-0"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	read := make(chan error)
+	go func() {
+		_, err := cachedGrants(db, builder, false)
+		read <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	r.NoError(deleteGenericGrantRolesAndShares(db, "", builder, "SELECT", []string{"role-0"}, nil))
+	r.NoError(<-read)
+	r.NoError(mock.ExpectationsWereMet())
+
+	mock.ExpectQuery(`^SHOW GRANTS ON VIEW "test-db"."PUBLIC"."test-view"$`).WillReturnRows(viewGrantRows(1))
+	grants, err := cachedGrants(db, builder, false)
+	r.NoError(err)
+	r.Len(grants, 1)
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGrantChangesInvalidateOtherObjects(t *testing.T) {
+	r := require.New(t)
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	// Granting on all streams of a schema changes the grants of each stream, so the stream is read again.
+	builder := snowflake.StreamGrant("test-db", "PUBLIC", "test-stream")
+	mock.ExpectQuery(`^SHOW GRANTS ON STREAM "test-db"."PUBLIC"."test-stream"$`).WillReturnRows(viewGrantRows(1))
+	_, err = cachedGrants(db, builder, false)
+	r.NoError(err)
+
+	mock.ExpectExec(`^GRANT SELECT ON ALL STREAMS IN SCHEMA "test-db"."PUBLIC" TO ROLE "role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+	r.NoError(createGenericGrantRolesAndShares(db, "", "", snowflake.AllStreamGrant("test-db", "PUBLIC"), "SELECT", false, []string{"role-1"}, nil))
+
+	mock.ExpectQuery(`^SHOW GRANTS ON STREAM "test-db"."PUBLIC"."test-stream"$`).WillReturnRows(viewGrantRows(2))
+	grants, err := cachedGrants(db, builder, false)
+	r.NoError(err)
+	r.Len(grants, 2)
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestSerializeGrantsPerObject(t *testing.T) {
+	r := require.New(t)
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+	mock.MatchExpectationsInOrder(false)
+	SerializeGrantsPerObject(db)
+
+	view := snowflake.ViewGrant("test-db", "PUBLIC", "test-view")
+	otherView := snowflake.ViewGrant("test-db", "PUBLIC", "other-view")
+	mock.ExpectExec(`^GRANT SELECT ON VIEW "test-db"."PUBLIC"."other-view" TO ROLE "role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`^GRANT SELECT ON VIEW "test-db"."PUBLIC"."test-view" TO ROLE "role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// Hold the lock on test-view as a first, still running grant operation would.
+	unlock := grantObjectLocks.lock(db, view)
+
+	// A grant on another object is not held up.
+	r.NoError(createGenericGrantRolesAndShares(db, "", "", otherView, "SELECT", false, []string{"role-1"}, nil))
+
+	// A second grant on the same object waits for the first one to finish.
+	done := make(chan error)
+	go func() {
+		done <- createGenericGrantRolesAndShares(db, "", "", view, "SELECT", false, []string{"role-2"}, nil)
+	}()
+	select {
+	case <-done:
+		r.Fail("grant on test-view ran while another grant operation held the object")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	unlock()
+	r.NoError(<-done)
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGrantsNotSerializedByDefault(t *testing.T) {
+	r := require.New(t)
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	view := snowflake.ViewGrant("test-db", "PUBLIC", "test-view")
+	mock.ExpectExec(`^GRANT SELECT ON VIEW "test-db"."PUBLIC"."test-view" TO ROLE "role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	unlock := grantObjectLocks.lock(db, view)
+	defer unlock()
+	r.NoError(createGenericGrantRolesAndShares(db, "", "", view, "SELECT", false, []string{"role-1"}, nil))
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+// benchmarkGrantRefresh simulates the refresh that precedes destroying one grant resource per role
+// on a single view: every resource reads the grants on the view.
+func benchmarkGrantRefresh(b *testing.B, read func(*sql.DB, snowflake.GrantBuilder, bool) ([]*grant, error)) {
+	b.Helper()
+	const grantResources = 200
+	builder := snowflake.ViewGrant("test-db", "PUBLIC", "test-view")
+	for i := 0; i < b.N; i++ {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			b.Fatal(err)
+		}
+		mock.MatchExpectationsInOrder(false)
+		for j := 0; j < grantResources; j++ {
+			mock.ExpectQuery(`^SHOW GRANTS ON VIEW`).WillReturnRows(viewGrantRows(grantResources))
+		}
+		for j := 0; j < grantResources; j++ {
+			if _, err := read(db, builder, false); err != nil {
+				b.Fatal(err)
+			}
+		}
+		db.Close()
+	}
+}
+
+func BenchmarkGrantRefreshUncached(b *testing.B) {
+	benchmarkGrantRefresh(b, queryGrants)
+}
+
+func BenchmarkGrantRefreshCached(b *testing.B) {
+	benchmarkGrantRefresh(b, cachedGrants)
+}
+
+func TestCheckBulkGrantSupport(t *testing.T) {
+	r := require.New(t)
+
+	r.NoError(checkBulkGrantSupport("TABLE", true, false))
+	r.NoError(checkBulkGrantSupport("SCHEMA", false, true))
+	r.NoError(checkBulkGrantSupport("MASKING POLICY", false, false))
+
+	err := checkBulkGrantSupport("MASKING POLICY", true, false)
+	r.EqualError(err, "on_future is not supported for MASKING POLICY grants, Snowflake cannot grant privileges on future MASKING POLICY objects")
+
+	err = checkBulkGrantSupport("ROW ACCESS POLICY", false, true)
+	r.EqualError(err, "on_all is not supported for ROW ACCESS POLICY grants, Snowflake cannot grant privileges on all ROW ACCESS POLICY objects")
+
+	r.Error(checkBulkGrantSupport("INTEGRATION", true, false))
+}
+
+func TestValidateBulkGrantSupportOnPlan(t *testing.T) {
+	r := require.New(t)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"database_name": "test-db",
+		"schema_name":   "test-schema",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role"},
+		"on_future":     true,
+	})
+	_, err := TableGrant().Resource.Diff(context.Background(), nil, config, nil)
+	r.NoError(err)
+
+	resource := TableGrant().Resource
+	resource.CustomizeDiff = validateBulkGrantSupport("MASKING POLICY")
+	_, err = resource.Diff(context.Background(), nil, config, nil)
+	r.ErrorContains(err, "on_future is not supported for MASKING POLICY grants")
+}
+
+func TestReadGenericFutureGrantsColumnLayouts(t *testing.T) {
+	createdOn := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		rows *sqlmock.Rows
+	}{
+		{
+			name: "SHOW FUTURE GRANTS layout",
+			rows: sqlmock.NewRows([]string{"created_on", "privilege", "grant_on", "name", "grant_to", "grantee_name", "grant_option"}).
+				AddRow(createdOn, "SELECT", "VIEW", "test-db.PUBLIC.<VIEW>", "ROLE", "reader", false),
+		},
+		{
+			name: "SHOW GRANTS layout",
+			rows: sqlmock.NewRows([]string{"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by"}).
+				AddRow(createdOn, "SELECT", "VIEW", "test-db.PUBLIC.<VIEW>", "ROLE", "reader", false, "SYSADMIN"),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+			db, mock, err := sqlmock.New()
+			r.NoError(err)
+			defer db.Close()
+
+			builder := snowflake.FutureViewGrant("test-db", "PUBLIC")
+			mock.ExpectQuery(`^SHOW FUTURE GRANTS IN SCHEMA "test-db"."PUBLIC"$`).WillReturnRows(tt.rows)
+
+			grants, err := readGenericFutureGrants(db, builder)
+			r.NoError(err)
+			r.Len(grants, 1)
+			r.Equal(&grant{
+				CreatedOn:   createdOn,
+				Privilege:   "SELECT",
+				GrantType:   "VIEW",
+				GrantName:   "test-db.PUBLIC.<VIEW>",
+				GranteeType: "ROLE",
+				GranteeName: "reader",
+				GrantOption: false,
+			}, grants[0])
+			r.NoError(mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSplitArgumentDataTypes(t *testing.T) {
+	r := require.New(t)
+	r.Empty(splitArgumentDataTypes(""))
+	r.Equal([]string{"VARCHAR"}, splitArgumentDataTypes("VARCHAR"))
+	r.Equal([]string{"NUMBER(38,0)", "VARCHAR(10)", "FLOAT"}, splitArgumentDataTypes("NUMBER(38,0), VARCHAR(10),FLOAT"))
+}
@@ -0,0 +1,185 @@
+package resources
+
+import (
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// grantID is the decoded ID of a grant resource: the object the privilege is granted on, the
+// privilege and the grant option.
+type grantID interface {
+	String() string
+	// privilege returns the granted privilege.
+	privilege() string
+	// withGrantOption reports whether the grantees may grant the privilege on.
+	withGrantOption() bool
+	// onFuture reports whether the privilege is granted on future objects.
+	onFuture() bool
+	// onAll reports whether the privilege is granted on all existing objects.
+	onAll() bool
+	// setObject writes the fields naming the object granted on, including on_future and on_all, and
+	// any other field the ID encodes besides the privilege and grant option to d.
+	setObject(d *schema.ResourceData) error
+}
+
+// grantIDCodec converts between the configuration or state of a grant resource and its grantID.
+type grantIDCodec struct {
+	// fromConfig builds the ID of the grant configured in d, rejecting invalid combinations of the
+	// fields naming the object.
+	fromConfig func(d *schema.ResourceData) (grantID, error)
+	// parse decodes an ID stored in the state.
+	parse func(s string) (grantID, error)
+}
+
+// grantBuilderFactory returns the builder for the object a grant ID names.
+type grantBuilderFactory func(id grantID) snowflake.GrantBuilder
+
+// grantCRUD holds the functions implementing a grant resource.
+type grantCRUD struct {
+	Create schema.CreateFunc
+	Read   schema.ReadFunc
+	Update schema.UpdateFunc
+	Delete schema.DeleteFunc
+}
+
+// grantResourceCRUD returns the Create, Read, Update and Delete functions of a grant resource on
+// top of the generic grant helpers, so that a grant resource only declares its schema, how to
+// build the grant for its ID and how its ID is encoded. The grantees (roles, and shares when the
+// schema has them) can be updated in place, and so can the privilege unless the schema forces a
+// new resource for it.
+func grantResourceCRUD(grantSchema map[string]*schema.Schema, newBuilder grantBuilderFactory, privileges PrivilegeSet, codec grantIDCodec) *grantCRUD {
+	crud := &grantCRUD{}
+	_, hasShares := grantSchema["shares"]
+
+	crud.Create = func(d *schema.ResourceData, meta interface{}) error {
+		id, err := codec.fromConfig(d)
+		if err != nil {
+			return err
+		}
+		if err := createGenericGrant(d, meta, newBuilder(id)); err != nil {
+			return err
+		}
+		d.SetId(id.String())
+		return crud.Read(d, meta)
+	}
+
+	crud.Read = func(d *schema.ResourceData, meta interface{}) error {
+		id, err := codec.parse(d.Id())
+		if err != nil {
+			return err
+		}
+		if err := id.setObject(d); err != nil {
+			return err
+		}
+		if err := d.Set("privilege", id.privilege()); err != nil {
+			return err
+		}
+		if err := d.Set("with_grant_option", id.withGrantOption()); err != nil {
+			return err
+		}
+		// a grant on all objects is expanded into grants on each object when it is executed, so it
+		// is read back from each of them
+		if id.onAll() {
+			return readAllGrant(d, meta, newBuilder(id), privileges)
+		}
+		if err := readGenericGrant(d, meta, grantSchema, newBuilder(id), id.onFuture(), privileges); err != nil {
+			return err
+		}
+		// upgrade a legacy pipe-delimited ID in place; it is rebuilt from the state just read
+		// rather than from the parsed ID, which lacks the grantees and enable_multiple_grants
+		if d.Id() != "" && !strings.Contains(d.Id(), "❄️") {
+			upgraded, err := codec.fromConfig(d)
+			if err != nil {
+				return err
+			}
+			d.SetId(upgraded.String())
+		}
+		return nil
+	}
+
+	// updatePrivilege revokes the old privilege from the old grantees and grants the new one to
+	// the new grantees. The ID encodes the privilege, so it is recomputed.
+	updatePrivilege := func(d *schema.ResourceData, meta interface{}) error {
+		id, err := codec.parse(d.Id())
+		if err != nil {
+			return err
+		}
+		newID, err := codec.fromConfig(d)
+		if err != nil {
+			return err
+		}
+
+		oldRoles, _ := d.GetChange("roles")
+		oldShares := []string{}
+		if hasShares {
+			o, _ := d.GetChange("shares")
+			oldShares = expandStringList(o.(*schema.Set).List())
+		}
+		// first revoke, so that revoking e.g. ALL PRIVILEGES does not take back the new privilege
+		if err := deleteGenericGrantRolesAndShares(
+			meta, d.Get("as_role").(string), newBuilder(id), id.privilege(), expandStringList(oldRoles.(*schema.Set).List()), oldShares,
+		); err != nil {
+			return err
+		}
+		// then add
+		if err := createGenericGrant(d, meta, newBuilder(newID)); err != nil {
+			return err
+		}
+
+		d.SetId(newID.String())
+		return crud.Read(d, meta)
+	}
+
+	crud.Update = func(d *schema.ResourceData, meta interface{}) error {
+		if d.HasChange("privilege") {
+			return updatePrivilege(d, meta)
+		}
+		// if neither the privilege nor the grantees changed, nothing to update and we're done
+		if !d.HasChanges("roles") && !(hasShares && d.HasChanges("shares")) {
+			return nil
+		}
+
+		rolesToAdd, rolesToRevoke := []string{}, []string{}
+		sharesToAdd, sharesToRevoke := []string{}, []string{}
+		if d.HasChange("roles") {
+			rolesToAdd, rolesToRevoke = changeDiff(d, "roles")
+		}
+		if hasShares && d.HasChange("shares") {
+			sharesToAdd, sharesToRevoke = changeDiff(d, "shares")
+		}
+
+		id, err := codec.parse(d.Id())
+		if err != nil {
+			return err
+		}
+		builder := newBuilder(id)
+
+		// first revoke
+		if err := deleteGenericGrantRolesAndShares(
+			meta, d.Get("as_role").(string), builder, id.privilege(), rolesToRevoke, sharesToRevoke,
+		); err != nil {
+			return err
+		}
+		// then add
+		if err := createGenericGrantRolesAndShares(
+			meta, d.Get("as_role").(string), d.Get("current_grants").(string), builder, id.privilege(), id.withGrantOption(), rolesToAdd, sharesToAdd,
+		); err != nil {
+			return err
+		}
+
+		// Done, refresh state
+		return crud.Read(d, meta)
+	}
+
+	crud.Delete = func(d *schema.ResourceData, meta interface{}) error {
+		id, err := codec.parse(d.Id())
+		if err != nil {
+			return err
+		}
+		return deleteGenericGrant(d, meta, newBuilder(id))
+	}
+
+	return crud
+}
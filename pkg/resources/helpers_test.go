@@ -1,9 +1,11 @@
 package resources_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
@@ -215,6 +217,18 @@ func roleGrants(t *testing.T, id string, params map[string]interface{}) *schema.
 	return d
 }
 
+func roleGrantsUpdate(t *testing.T, id string, params map[string]interface{}, newParams map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	r := require.New(t)
+	res := resources.RoleGrants()
+	state := roleGrants(t, id, params).State()
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(newParams), nil)
+	r.NoError(err)
+	d, err := schema.InternalMap(res.Schema).Data(state, diff)
+	r.NoError(err)
+	return d
+}
+
 func userOwnershipGrant(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	t.Helper()
 	r := require.New(t)
@@ -359,6 +373,18 @@ func view(t *testing.T, id string, params map[string]interface{}) *schema.Resour
 	return d
 }
 
+func viewUpdate(t *testing.T, id string, params map[string]interface{}, newParams map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	r := require.New(t)
+	res := resources.View()
+	state := view(t, id, params).State()
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(newParams), nil)
+	r.NoError(err)
+	d, err := schema.InternalMap(res.Schema).Data(state, diff)
+	r.NoError(err)
+	return d
+}
+
 func materializedView(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	t.Helper()
 	r := require.New(t)
@@ -404,6 +430,29 @@ func sequenceGrant(t *testing.T, id string, params map[string]interface{}) *sche
 	return d
 }
 
+func alertGrant(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.AlertGrant().Resource.Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+// alertGrantUpdate returns the data of an alert grant with ID id whose state was applied from
+// params and whose configuration changed to newParams, as passed to UpdateAlertGrant.
+func alertGrantUpdate(t *testing.T, id string, params map[string]interface{}, newParams map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	r := require.New(t)
+	res := resources.AlertGrant().Resource
+	state := alertGrant(t, id, params).State()
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(newParams), nil)
+	r.NoError(err)
+	d, err := schema.InternalMap(res.Schema).Data(state, diff)
+	r.NoError(err)
+	return d
+}
+
 func streamGrant(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	t.Helper()
 	r := require.New(t)
@@ -413,6 +462,20 @@ func streamGrant(t *testing.T, id string, params map[string]interface{}) *schema
 	return d
 }
 
+// streamGrantUpdate returns the data of a stream grant with ID id whose state was applied from
+// params and whose configuration changed to newParams, as passed to UpdateStreamGrant.
+func streamGrantUpdate(t *testing.T, id string, params map[string]interface{}, newParams map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	r := require.New(t)
+	res := resources.StreamGrant().Resource
+	state := streamGrant(t, id, params).State()
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(newParams), nil)
+	r.NoError(err)
+	d, err := schema.InternalMap(res.Schema).Data(state, diff)
+	r.NoError(err)
+	return d
+}
+
 func functionGrant(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	t.Helper()
 	r := require.New(t)
@@ -493,3 +556,12 @@ func tagGrant(t *testing.T, id string, params map[string]interface{}) *schema.Re
 	d.SetId(id)
 	return d
 }
+
+func objectGrantsExclusive(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.ObjectGrantsExclusive().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
@@ -10,10 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validIntegrationPrivileges = NewPrivilegeSet(
-	privilegeUsage,
-	privilegeOwnership,
-)
+var validIntegrationPrivileges = privilegesFor("INTEGRATION")
 
 var integrationGrantSchema = map[string]*schema.Schema{
 	"integration_name": {
@@ -50,6 +47,10 @@ var integrationGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 }
 
 // IntegrationGrant returns a pointer to the resource representing a integration grant.
@@ -150,13 +151,13 @@ func UpdateIntegrationGrant(d *schema.ResourceData, meta interface{}) error {
 	// first revoke
 
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, rolesToRevoke, []string{},
+		meta, d.Get("as_role").(string), builder, grantID.Privilege, rolesToRevoke, []string{},
 	); err != nil {
 		return err
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
+		meta, d.Get("as_role").(string), d.Get("current_grants").(string), builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
 	); err != nil {
 		return err
 	}
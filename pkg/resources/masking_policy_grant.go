@@ -10,10 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validMaskingPoilcyPrivileges = NewPrivilegeSet(
-	privilegeOwnership,
-	privilegeApply,
-)
+var validMaskingPoilcyPrivileges = privilegesFor("MASKING POLICY")
 
 var maskingPolicyGrantSchema = map[string]*schema.Schema{
 	"database_name": {
@@ -62,6 +59,10 @@ var maskingPolicyGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 }
 
 // MaskingPolicyGrant returns a pointer to the resource representing a masking policy grant.
@@ -172,13 +173,13 @@ func UpdateMaskingPolicyGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, rolesToRevoke, []string{},
+		meta, d.Get("as_role").(string), builder, grantID.Privilege, rolesToRevoke, []string{},
 	); err != nil {
 		return err
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
+		meta, d.Get("as_role").(string), d.Get("current_grants").(string), builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
 	); err != nil {
 		return err
 	}
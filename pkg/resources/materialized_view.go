@@ -34,7 +34,7 @@ var materializedViewSchema = map[string]*schema.Schema{
 	"warehouse": {
 		Type:        schema.TypeString,
 		Required:    true,
-		Description: "The warehouse name.",
+		Description: "The warehouse name. A warning is logged when creating the materialized view resumes this warehouse from a suspended state.",
 		ForceNew:    true,
 	},
 	"or_replace": {
@@ -61,6 +61,27 @@ var materializedViewSchema = map[string]*schema.Schema{
 		ForceNew:         true,
 		DiffSuppressFunc: DiffSuppressStatement,
 	},
+	"validate_statement": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When true, the statement is checked before creating the materialized view for constructs Snowflake does not allow in materialized views (joins, set operators, HAVING, ORDER BY, LIMIT, window functions and non-deterministic functions) and the creation fails with the list of the ones found. The check is a best-effort text scan and may report false positives, e.g. for a join inside a subquery.",
+	},
+	"refresh_lag": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "How far the materialized view is behind its base table, as reported by the `behind_by` column of SHOW MATERIALIZED VIEWS (e.g. `0s`).",
+	},
+	"refreshed_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The last time the materialized view was refreshed.",
+	},
+	"is_stale": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the materialized view is behind its base table.",
+	},
 	"tag": tagReferenceSchema,
 }
 
@@ -137,6 +158,12 @@ func CreateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 	warehouse := d.Get("warehouse").(string)
 	s := d.Get("statement").(string)
 
+	if d.Get("validate_statement").(bool) {
+		if found := snowflake.MaterializedViewRestrictions(s); len(found) > 0 {
+			return fmt.Errorf("the statement of materialized view %v contains constructs materialized views do not support: %v", name, strings.Join(found, ", "))
+		}
+	}
+
 	builder := snowflake.NewMaterializedViewBuilder(name).WithDB(database).WithSchema(schema).WithWarehouse(warehouse).WithStatement(s)
 
 	// Set optionals
@@ -159,9 +186,16 @@ func CreateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 
 	q := builder.Create()
 	log.Print("[DEBUG] xxx ", q)
+	before, err := snowflake.ShowWarehouse(db, warehouse)
+	if err != nil {
+		log.Printf("[DEBUG] unable to read the state of warehouse %v, not checking whether creating materialized view %v resumes it: %v", warehouse, name, err)
+	}
 	if err := snowflake.ExecMulti(db, q); err != nil {
 		return fmt.Errorf("error creating view %v err = %w", name, err)
 	}
+	if before != nil && before.IsSuspended() {
+		warnIfWarehouseResumed(db, warehouse, fmt.Sprintf("creating materialized view %v", name))
+	}
 
 	materializedViewID := &materializedViewID{
 		DatabaseName: database,
@@ -177,6 +211,25 @@ func CreateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 	return ReadMaterializedView(d, meta)
 }
 
+// warnIfWarehouseResumed logs a warning when the warehouse, suspended before the operation, is no
+// longer suspended after it: the provider directed the operation to it with USE WAREHOUSE, so the
+// warehouse was resumed on the provider's behalf and keeps accruing credits until it auto-suspends.
+func warnIfWarehouseResumed(db *sql.DB, warehouse string, operation string) {
+	after, err := snowflake.ShowWarehouse(db, warehouse)
+	if err != nil {
+		log.Printf("[DEBUG] unable to read the state of warehouse %v after %v: %v", warehouse, operation, err)
+		return
+	}
+	if after.IsSuspended() {
+		return
+	}
+	if !after.AutoSuspend.Valid || after.AutoSuspend.Int64 == 0 {
+		log.Printf("[WARN] warehouse %v was suspended and has been resumed by %v; it has no auto_suspend set and will keep running until it is suspended manually", warehouse, operation)
+		return
+	}
+	log.Printf("[WARN] warehouse %v was suspended and has been resumed by %v; it will keep running until it auto-suspends after %v seconds of inactivity", warehouse, operation, after.AutoSuspend.Int64)
+}
+
 // ReadMaterializedView implements schema.ReadFunc.
 func ReadMaterializedView(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
@@ -230,6 +283,18 @@ func ReadMaterializedView(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := d.Set("refresh_lag", v.BehindBy.String); err != nil {
+		return err
+	}
+
+	if err := d.Set("refreshed_on", v.RefreshedOn.String); err != nil {
+		return err
+	}
+
+	if err := d.Set("is_stale", v.IsStale()); err != nil {
+		return err
+	}
+
 	return d.Set("database", v.DatabaseName.String)
 }
 
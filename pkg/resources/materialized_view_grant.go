@@ -7,6 +7,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -16,11 +17,7 @@ NewPriviligeSet creates a set of privileges that are allowed
 They are used for validation in the schema object below.
 */
 
-var validMaterializedViewPrivileges = NewPrivilegeSet(
-	privilegeOwnership,
-	privilegeReferences,
-	privilegeSelect,
-)
+var validMaterializedViewPrivileges = privilegesFor("MATERIALIZED VIEW")
 
 // The schema holds the resource variables that can be provided in the Terraform.
 var materializedViewGrantSchema = map[string]*schema.Schema{
@@ -58,7 +55,7 @@ var materializedViewGrantSchema = map[string]*schema.Schema{
 	},
 	"shares": {
 		Type:        schema.TypeSet,
-		Elem:        &schema.Schema{Type: schema.TypeString},
+		Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: snowflakeValidation.ValidateShareName},
 		Optional:    true,
 		Description: "Grants privilege to these shares (only valid if on_future is false).",
 	},
@@ -69,6 +66,7 @@ var materializedViewGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"revoke_existing_on_delete": revokeExistingOnDeleteSchema,
 	"with_grant_option": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -83,6 +81,10 @@ var materializedViewGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 }
 
 // ViewGrant returns a pointer to the resource representing a view grant.
@@ -94,9 +96,12 @@ func MaterializedViewGrant() *TerraformGrantResource {
 			Delete: DeleteMaterializedViewGrant,
 			Update: UpdateMaterializedViewGrant,
 
-			Schema: materializedViewGrantSchema,
+			Schema:        materializedViewGrantSchema,
+			CustomizeDiff: validateBulkGrantSupport("MATERIALIZED VIEW"),
 			Importer: &schema.ResourceImporter{
-				StateContext: schema.ImportStatePassthroughContext,
+				StateContext: importFutureGrantWildcard(snowflake.FutureMaterializedViewGrant, func(g *futureGrantImport) string {
+					return NewMaterializedViewGrantID(g.DatabaseName, g.SchemaName, "", g.Privilege, g.Roles, []string{}, g.WithGrantOption).String()
+				}),
 			},
 		},
 		ValidPrivs: validMaterializedViewPrivileges,
@@ -246,13 +251,13 @@ func UpdateMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, rolesToRevoke, sharesToRevoke,
+		meta, d.Get("as_role").(string), builder, grantID.Privilege, rolesToRevoke, sharesToRevoke,
 	); err != nil {
 		return err
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, sharesToAdd,
+		meta, d.Get("as_role").(string), d.Get("current_grants").(string), builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, sharesToAdd,
 	); err != nil {
 		return err
 	}
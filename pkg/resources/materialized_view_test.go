@@ -1,7 +1,10 @@
 package resources_test
 
 import (
+	"bytes"
 	"database/sql"
+	"log"
+	"os"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -50,6 +53,61 @@ func TestMaterializedViewCreate(t *testing.T) {
 	})
 }
 
+func TestMaterializedViewCreateRejectsInvalidStatement(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":               "good_name",
+		"database":           "test_db",
+		"schema":             "test_schema",
+		"warehouse":          "test_wh",
+		"statement":          "SELECT o.id, c.name FROM test_db.PUBLIC.ORDERS o JOIN test_db.PUBLIC.CUSTOMERS c ON o.customer_id = c.id ORDER BY o.id",
+		"validate_statement": true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.MaterializedView().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		err := resources.CreateMaterializedView(d, db)
+		r.EqualError(err, "the statement of materialized view good_name contains constructs materialized views do not support: a join (a materialized view can only query a single table), ORDER BY")
+	})
+}
+
+func TestMaterializedViewCreateWarnsWhenWarehouseResumed(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":      "good_name",
+		"database":  "test_db",
+		"schema":    "test_schema",
+		"warehouse": "test_wh",
+		"statement": "SELECT * FROM test_db.PUBLIC.GREAT_TABLE",
+	}
+	d := schema.TestResourceDataRaw(t, resources.MaterializedView().Schema, in)
+	r.NotNil(d)
+
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.MatchExpectationsInOrder(true)
+		mock.ExpectQuery(`^SHOW WAREHOUSES LIKE 'test_wh'$`).WillReturnRows(
+			sqlmock.NewRows([]string{"name", "state", "auto_suspend"}).AddRow("test_wh", "SUSPENDED", 60))
+		mock.ExpectBegin()
+		mock.ExpectExec(`^USE WAREHOUSE test_wh;$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^CREATE MATERIALIZED VIEW "test_db"."test_schema"."good_name" AS SELECT \* FROM test_db.PUBLIC.GREAT_TABLE$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectQuery(`^SHOW WAREHOUSES LIKE 'test_wh'$`).WillReturnRows(
+			sqlmock.NewRows([]string{"name", "state", "auto_suspend"}).AddRow("test_wh", "STARTED", 60))
+
+		expectReadMaterializedView(mock)
+		err := resources.CreateMaterializedView(d, db)
+		r.NoError(err)
+	})
+	r.Contains(logs.String(), "[WARN] warehouse test_wh was suspended and has been resumed by creating materialized view good_name")
+}
+
 func TestMaterializedViewCreateOrReplace(t *testing.T) {
 	r := require.New(t)
 
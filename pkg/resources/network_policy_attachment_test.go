@@ -39,6 +39,37 @@ func TestNetworkPolicyAttachmentCreate(t *testing.T) {
 	})
 }
 
+func TestNetworkPolicyAttachmentRead(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"network_policy_name": "test-network-policy",
+		"set_for_account":     true,
+		"users":               []interface{}{"test-user", "other-user"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.NetworkPolicyAttachment().Schema, in)
+	d.SetId("test-network-policy_attachment")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		columns := []string{"key", "value", "level"}
+		mock.ExpectQuery(`^SHOW PARAMETERS LIKE 'network_policy' IN USER "other-user"$`).WillReturnRows(
+			sqlmock.NewRows(columns).AddRow("NETWORK_POLICY", "another-network-policy", "USER"))
+		mock.ExpectQuery(`^SHOW PARAMETERS LIKE 'network_policy' IN USER "test-user"$`).WillReturnRows(
+			sqlmock.NewRows(columns).AddRow("NETWORK_POLICY", "test-network-policy", "USER"))
+		// the account has no network policy, so the parameter is reported at its default level
+		mock.ExpectQuery(`^SHOW PARAMETERS LIKE 'network_policy' IN ACCOUNT$`).WillReturnRows(
+			sqlmock.NewRows(columns).AddRow("NETWORK_POLICY", "", ""))
+
+		err := resources.ReadNetworkPolicyAttachment(d, db)
+		r.NoError(err)
+	})
+	r.Equal("test-network-policy", d.Get("network_policy_name").(string))
+	r.False(d.Get("set_for_account").(bool))
+	users := d.Get("users").(*schema.Set)
+	r.True(users.Contains("test-user"))
+	r.Equal(1, users.Len())
+}
+
 func TestNetworkPolicyAttachmentSetOnAccountDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -0,0 +1,378 @@
+package resources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var validExclusiveGrantObjectTypes = []string{
+	"DATABASE",
+	"EXTERNAL TABLE",
+	"FILE FORMAT",
+	"INTEGRATION",
+	"MASKING POLICY",
+	"MATERIALIZED VIEW",
+	"PIPE",
+	"RESOURCE MONITOR",
+	"ROW ACCESS POLICY",
+	"SCHEMA",
+	"SEQUENCE",
+	"STAGE",
+	"STREAM",
+	"TABLE",
+	"TAG",
+	"TASK",
+	"VIEW",
+	"WAREHOUSE",
+}
+
+var objectGrantsExclusiveSchema = map[string]*schema.Schema{
+	"object_type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The type of object on which the grants are managed, e.g. TABLE or WAREHOUSE.",
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(validExclusiveGrantObjectTypes, true),
+	},
+	"database_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The name of the database containing the object. Must be unset for account-level objects such as databases and warehouses.",
+		ForceNew:    true,
+	},
+	"schema_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The name of the schema containing the object. Must be unset for account-level objects and schemas.",
+		ForceNew:    true,
+	},
+	"object_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the object on which the grants are managed.",
+		ForceNew:    true,
+	},
+	"grant": {
+		Type:        schema.TypeSet,
+		Required:    true,
+		Description: "The complete set of privileges roles should hold on the object. Any other privilege found on the object is revoked.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"privilege": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The privilege to grant, in upper case. OWNERSHIP cannot be managed by this resource.",
+					ValidateFunc: validation.All(
+						validation.StringMatch(regexp.MustCompile(`^[A-Z_ ]+$`), "privilege must be upper case"),
+						validation.StringNotInSlice([]string{privilegeOwnership.String()}, false),
+					),
+				},
+				"role": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The role to grant the privilege to.",
+				},
+				"with_grant_option": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "When this is set to true, allows the recipient role to grant the privilege to other roles.",
+				},
+			},
+		},
+	},
+}
+
+// ObjectGrantsExclusive returns a pointer to the resource representing the complete set of
+// grants on a single object.
+//
+// Unlike the other grant resources this one is authoritative: on every create and update any
+// privilege granted to a role on the object that is not declared in `grant` is revoked, regardless
+// of who granted it. OWNERSHIP and the grants Snowflake seeds itself are never touched.
+func ObjectGrantsExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateObjectGrantsExclusive,
+		Read:   ReadObjectGrantsExclusive,
+		Update: UpdateObjectGrantsExclusive,
+		Delete: DeleteObjectGrantsExclusive,
+
+		Description: "Manages the complete set of privileges granted to roles on a single object. This resource is authoritative: " +
+			"any privilege on the object that is not declared in `grant` is revoked on every apply, including grants made outside of Terraform. " +
+			"OWNERSHIP and grants seeded by Snowflake are left untouched. On destroy only the declared grants are revoked.",
+		Schema: objectGrantsExclusiveSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+type ObjectGrantsExclusiveID struct {
+	ObjectType   string
+	DatabaseName string
+	SchemaName   string
+	ObjectName   string
+}
+
+func (v *ObjectGrantsExclusiveID) String() string {
+	return strings.Join([]string{v.ObjectType, v.DatabaseName, v.SchemaName, v.ObjectName}, "|")
+}
+
+func parseObjectGrantsExclusiveID(s string) (*ObjectGrantsExclusiveID, error) {
+	idParts := strings.Split(s, "|")
+	if len(idParts) != 4 {
+		return nil, fmt.Errorf("unexpected number of ID parts (%d), expected 4: object_type|database_name|schema_name|object_name", len(idParts))
+	}
+	return &ObjectGrantsExclusiveID{
+		ObjectType:   strings.ToUpper(idParts[0]),
+		DatabaseName: idParts[1],
+		SchemaName:   idParts[2],
+		ObjectName:   idParts[3],
+	}, nil
+}
+
+func (v *ObjectGrantsExclusiveID) builder() snowflake.GrantBuilder {
+	var nameParts []string
+	for _, p := range []string{v.DatabaseName, v.SchemaName, v.ObjectName} {
+		if p != "" {
+			nameParts = append(nameParts, p)
+		}
+	}
+	return snowflake.ObjectGrant(v.ObjectType, nameParts...)
+}
+
+// exclusiveGrant is a single privilege held by a single role.
+type exclusiveGrant struct {
+	Privilege       string
+	Role            string
+	WithGrantOption bool
+}
+
+func (g exclusiveGrant) key() string {
+	return fmt.Sprintf("%v|%v|%v", g.Privilege, g.Role, g.WithGrantOption)
+}
+
+func expandExclusiveGrants(v interface{}) []exclusiveGrant {
+	var grants []exclusiveGrant
+	for _, raw := range v.(*schema.Set).List() {
+		m := raw.(map[string]interface{})
+		grants = append(grants, exclusiveGrant{
+			Privilege:       m["privilege"].(string),
+			Role:            m["role"].(string),
+			WithGrantOption: m["with_grant_option"].(bool),
+		})
+	}
+	return grants
+}
+
+// readExclusiveGrants returns every privilege granted to a role on the object, skipping OWNERSHIP
+// and the grants seeded by Snowflake (which have an empty granted_by).
+func readExclusiveGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]exclusiveGrant, error) {
+	grants, err := readGenericCurrentGrants(db, builder)
+	if err != nil {
+		return nil, err
+	}
+	var out []exclusiveGrant
+	for _, g := range grants {
+		if g.GranteeType != "ROLE" || g.Privilege == privilegeOwnership.String() {
+			continue
+		}
+		out = append(out, exclusiveGrant{
+			Privilege:       g.Privilege,
+			Role:            g.GranteeName,
+			WithGrantOption: g.GrantOption,
+		})
+	}
+	return out, nil
+}
+
+// reconcileExclusiveGrants makes the grants on the object match desired exactly: anything not
+// desired is revoked first, then anything missing is granted. A grant whose grant option differs
+// is revoked and granted again since REVOKE removes both.
+func reconcileExclusiveGrants(db *sql.DB, builder snowflake.GrantBuilder, desired []exclusiveGrant) error {
+	return changeGrants(db, builder, func() error {
+		current, err := readExclusiveGrants(db, builder)
+		if err != nil {
+			return err
+		}
+
+		desiredKeys := map[string]bool{}
+		for _, g := range desired {
+			desiredKeys[g.key()] = true
+		}
+		currentKeys := map[string]bool{}
+		for _, g := range current {
+			role := g.Role
+			if matched := matchExclusiveGrant(g, desired, current); matched != nil {
+				g.Role = matched.Role
+			}
+			currentKeys[g.key()] = true
+			if desiredKeys[g.key()] {
+				continue
+			}
+			log.Printf("[DEBUG] revoking unmanaged %v on %v from role %v", g.Privilege, builder.Name(), role)
+			if err := snowflake.ExecMulti(db, builder.Role(role).Revoke(g.Privilege)); err != nil {
+				return err
+			}
+		}
+
+		for _, g := range desired {
+			if currentKeys[g.key()] {
+				continue
+			}
+			if err := snowflake.Exec(db, builder.Role(g.Role).Grant(g.Privilege, g.WithGrantOption)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// matchExclusiveGrant finds the desired grant that g, read from current, corresponds to when SHOW
+// GRANTS reported its role upper-cased, see matchGranteeName.
+func matchExclusiveGrant(g exclusiveGrant, desired []exclusiveGrant, current []exclusiveGrant) *exclusiveGrant {
+	read := func(role string) bool {
+		for _, c := range current {
+			if c.Privilege == g.Privilege && c.Role == role {
+				return true
+			}
+		}
+		return false
+	}
+	for i := range desired {
+		if desired[i].Privilege == g.Privilege && sameGranteeName(desired[i].Role, g.Role) && (desired[i].Role == g.Role || !read(desired[i].Role)) {
+			return &desired[i]
+		}
+	}
+	return nil
+}
+
+// CreateObjectGrantsExclusive implements schema.CreateFunc.
+func CreateObjectGrantsExclusive(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	grantID := &ObjectGrantsExclusiveID{
+		ObjectType:   strings.ToUpper(d.Get("object_type").(string)),
+		DatabaseName: d.Get("database_name").(string),
+		SchemaName:   d.Get("schema_name").(string),
+		ObjectName:   d.Get("object_name").(string),
+	}
+	if grantID.SchemaName != "" && grantID.DatabaseName == "" {
+		return errors.New("database_name must be set when schema_name is set")
+	}
+
+	if err := reconcileExclusiveGrants(db, grantID.builder(), expandExclusiveGrants(d.Get("grant"))); err != nil {
+		return err
+	}
+
+	d.SetId(grantID.String())
+	return ReadObjectGrantsExclusive(d, meta)
+}
+
+// ReadObjectGrantsExclusive implements schema.ReadFunc.
+func ReadObjectGrantsExclusive(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	grantID, err := parseObjectGrantsExclusiveID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	current, err := readExclusiveGrants(db, grantID.builder())
+	if err != nil {
+		if isObjectNotExistError(err) {
+			log.Printf("[WARN] object for grants (%s) not found, removing from state file", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	var desired []exclusiveGrant
+	if v, ok := d.GetOk("grant"); ok {
+		desired = expandExclusiveGrants(v)
+	}
+	grants := make([]interface{}, 0, len(current))
+	for _, g := range current {
+		if matched := matchExclusiveGrant(g, desired, current); matched != nil {
+			g.Role = matched.Role
+		}
+		grants = append(grants, map[string]interface{}{
+			"privilege":         g.Privilege,
+			"role":              g.Role,
+			"with_grant_option": g.WithGrantOption,
+		})
+	}
+
+	if err := d.Set("object_type", grantID.ObjectType); err != nil {
+		return err
+	}
+	if err := d.Set("database_name", grantID.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema_name", grantID.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("object_name", grantID.ObjectName); err != nil {
+		return err
+	}
+	return d.Set("grant", grants)
+}
+
+// UpdateObjectGrantsExclusive implements schema.UpdateFunc.
+func UpdateObjectGrantsExclusive(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	grantID, err := parseObjectGrantsExclusiveID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("grant") {
+		if err := reconcileExclusiveGrants(db, grantID.builder(), expandExclusiveGrants(d.Get("grant"))); err != nil {
+			return err
+		}
+	}
+	return ReadObjectGrantsExclusive(d, meta)
+}
+
+// DeleteObjectGrantsExclusive implements schema.DeleteFunc. Only the grants declared in the
+// configuration are revoked.
+func DeleteObjectGrantsExclusive(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	grantID, err := parseObjectGrantsExclusiveID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	builder := grantID.builder()
+	err = changeGrants(db, builder, func() error {
+		for _, g := range expandExclusiveGrants(d.Get("grant")) {
+			if err := snowflake.ExecMulti(db, builder.Role(g.Role).Revoke(g.Privilege)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		// Error 2003 is also returned when a role was dropped, so it only means the grants are gone
+		// if the object itself no longer exists.
+		if !isObjectNotExistError(err) {
+			return err
+		}
+		exists, existsErr := grantObjectExists(db, builder)
+		if existsErr != nil {
+			return fmt.Errorf("%w; unable to check whether %v still exists: %v", err, builder.Name(), existsErr)
+		}
+		if exists {
+			return err
+		}
+		log.Printf("[WARN] object for grants (%s) no longer exists, removing from state file", d.Id())
+	}
+	d.SetId("")
+	return nil
+}
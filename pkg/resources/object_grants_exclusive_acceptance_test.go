@@ -0,0 +1,63 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ObjectGrantsExclusive(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	roleName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: objectGrantsExclusiveConfig(databaseName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_object_grants_exclusive.test", "object_type", "DATABASE"),
+					resource.TestCheckResourceAttr("snowflake_object_grants_exclusive.test", "object_name", databaseName),
+					resource.TestCheckResourceAttr("snowflake_object_grants_exclusive.test", "grant.#", "2"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_object_grants_exclusive.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func objectGrantsExclusiveConfig(databaseName, roleName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+  name = "%v"
+}
+
+resource "snowflake_role" "test" {
+  name = "%v"
+}
+
+resource "snowflake_object_grants_exclusive" "test" {
+  object_type = "DATABASE"
+  object_name = snowflake_database.test.name
+
+  grant {
+    privilege = "USAGE"
+    role      = snowflake_role.test.name
+  }
+
+  grant {
+    privilege = "MONITOR"
+    role      = snowflake_role.test.name
+  }
+}
+`, databaseName, roleName)
+}
@@ -0,0 +1,204 @@
+package resources_test
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectGrantsExclusive(t *testing.T) {
+	r := require.New(t)
+	err := resources.ObjectGrantsExclusive().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func expectReadObjectGrantsExclusive(mock sqlmock.Sqlmock, rows *sqlmock.Rows) {
+	mock.ExpectQuery(`^SHOW GRANTS ON TABLE "test-db"."PUBLIC"."test-table"$`).WillReturnRows(rows)
+}
+
+func objectGrantsExclusiveRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+	})
+}
+
+func TestObjectGrantsExclusiveCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"object_type":   "TABLE",
+		"database_name": "test-db",
+		"schema_name":   "PUBLIC",
+		"object_name":   "test-table",
+		"grant": []interface{}{
+			map[string]interface{}{"privilege": "SELECT", "role": "reader", "with_grant_option": false},
+			map[string]interface{}{"privilege": "INSERT", "role": "writer", "with_grant_option": false},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resources.ObjectGrantsExclusive().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		now := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		// before: reader already has SELECT, someone granted UPDATE to intruder
+		expectReadObjectGrantsExclusive(mock, objectGrantsExclusiveRows().
+			AddRow(now, "OWNERSHIP", "TABLE", "test-table", "ROLE", "owner", false, "owner").
+			AddRow(now, "SELECT", "TABLE", "test-table", "ROLE", "reader", false, "owner").
+			AddRow(now, "UPDATE", "TABLE", "test-table", "ROLE", "intruder", false, "owner"))
+		mock.ExpectBegin()
+		mock.ExpectExec(`^REVOKE UPDATE ON TABLE "test-db"."PUBLIC"."test-table" FROM ROLE "intruder"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectExec(`^GRANT INSERT ON TABLE "test-db"."PUBLIC"."test-table" TO ROLE "writer"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		// after
+		expectReadObjectGrantsExclusive(mock, objectGrantsExclusiveRows().
+			AddRow(now, "OWNERSHIP", "TABLE", "test-table", "ROLE", "owner", false, "owner").
+			AddRow(now, "SELECT", "TABLE", "test-table", "ROLE", "reader", false, "owner").
+			AddRow(now, "INSERT", "TABLE", "test-table", "ROLE", "writer", false, "owner"))
+
+		err := resources.CreateObjectGrantsExclusive(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("TABLE|test-db|PUBLIC|test-table", d.Id())
+	r.Equal(2, d.Get("grant").(*schema.Set).Len())
+}
+
+func TestObjectGrantsExclusiveCreateRevokesOtherRoleCase(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"object_type":   "TABLE",
+		"database_name": "test-db",
+		"schema_name":   "PUBLIC",
+		"object_name":   "test-table",
+		"grant": []interface{}{
+			map[string]interface{}{"privilege": "SELECT", "role": "analyst", "with_grant_option": false},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resources.ObjectGrantsExclusive().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		now := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		// ANALYST is a different role than the configured analyst, which holds SELECT as well
+		expectReadObjectGrantsExclusive(mock, objectGrantsExclusiveRows().
+			AddRow(now, "SELECT", "TABLE", "test-table", "ROLE", "analyst", false, "owner").
+			AddRow(now, "SELECT", "TABLE", "test-table", "ROLE", "ANALYST", false, "owner"))
+		mock.ExpectBegin()
+		mock.ExpectExec(`^REVOKE SELECT ON TABLE "test-db"."PUBLIC"."test-table" FROM ROLE "ANALYST"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		expectReadObjectGrantsExclusive(mock, objectGrantsExclusiveRows().
+			AddRow(now, "SELECT", "TABLE", "test-table", "ROLE", "analyst", false, "owner"))
+
+		err := resources.CreateObjectGrantsExclusive(d, db)
+		r.NoError(err)
+	})
+	r.Equal(1, d.Get("grant").(*schema.Set).Len())
+}
+
+func TestObjectGrantsExclusiveReadReportsUnmanaged(t *testing.T) {
+	r := require.New(t)
+
+	d := objectGrantsExclusive(t, "TABLE|test-db|PUBLIC|test-table", map[string]interface{}{
+		"object_type": "TABLE",
+		"object_name": "test-table",
+		"grant": []interface{}{
+			map[string]interface{}{"privilege": "SELECT", "role": "reader", "with_grant_option": false},
+		},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		now := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		expectReadObjectGrantsExclusive(mock, objectGrantsExclusiveRows().
+			AddRow(now, "SELECT", "TABLE", "test-table", "ROLE", "READER", false, "owner").
+			AddRow(now, "UPDATE", "TABLE", "test-table", "ROLE", "intruder", true, "owner").
+			AddRow(now, "SELECT", "TABLE", "test-table", "ROLE", "seeded", false, ""))
+		err := resources.ReadObjectGrantsExclusive(d, db)
+		r.NoError(err)
+	})
+
+	r.Equal("test-db", d.Get("database_name"))
+	r.Equal("PUBLIC", d.Get("schema_name"))
+	grants := d.Get("grant").(*schema.Set).List()
+	r.Len(grants, 2)
+	roles := []string{}
+	for _, g := range grants {
+		roles = append(roles, g.(map[string]interface{})["role"].(string))
+	}
+	r.ElementsMatch([]string{"reader", "intruder"}, roles)
+}
+
+func TestObjectGrantsExclusiveReadObjectDropped(t *testing.T) {
+	r := require.New(t)
+
+	d := objectGrantsExclusive(t, "TABLE|test-db|PUBLIC|test-table", map[string]interface{}{
+		"object_type": "TABLE",
+		"object_name": "test-table",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SHOW GRANTS ON TABLE "test-db"."PUBLIC"."test-table"$`).WillReturnError(objectNotExistError("test-table"))
+		err := resources.ReadObjectGrantsExclusive(d, db)
+		r.NoError(err)
+	})
+	r.Empty(d.Id())
+}
+
+func TestObjectGrantsExclusiveDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := objectGrantsExclusive(t, "WAREHOUSE|||test-wh", map[string]interface{}{
+		"object_type": "WAREHOUSE",
+		"object_name": "test-wh",
+		"grant": []interface{}{
+			map[string]interface{}{"privilege": "USAGE", "role": "reader", "with_grant_option": false},
+		},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`^REVOKE USAGE ON WAREHOUSE "test-wh" FROM ROLE "reader"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		err := resources.DeleteObjectGrantsExclusive(d, db)
+		r.NoError(err)
+	})
+	r.Empty(d.Id())
+}
+
+func TestObjectGrantsExclusiveDeleteObjectDropped(t *testing.T) {
+	r := require.New(t)
+
+	d := objectGrantsExclusive(t, "WAREHOUSE|||test-wh", map[string]interface{}{
+		"object_type": "WAREHOUSE",
+		"object_name": "test-wh",
+		"grant": []interface{}{
+			map[string]interface{}{"privilege": "USAGE", "role": "reader", "with_grant_option": false},
+		},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`^REVOKE USAGE ON WAREHOUSE "test-wh" FROM ROLE "reader"$`).WillReturnError(objectNotExistError("test-wh"))
+		mock.ExpectRollback()
+		mock.ExpectQuery(`^SHOW GRANTS ON WAREHOUSE "test-wh"$`).WillReturnError(objectNotExistError("test-wh"))
+		err := resources.DeleteObjectGrantsExclusive(d, db)
+		r.NoError(err)
+	})
+	r.Empty(d.Id())
+}
+
+func objectNotExistError(name string) error {
+	return &gosnowflake.SnowflakeError{
+		Number:  2003,
+		Message: fmt.Sprintf("Object '%v' does not exist or not authorized.", name),
+	}
+}
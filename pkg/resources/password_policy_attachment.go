@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var passwordPolicyAttachment = &policyAttachment{
+	policyKind:  "PASSWORD",
+	policyKey:   "password_policy",
+	objectTypes: []string{"USER", "ACCOUNT"},
+}
+
+var passwordPolicyAttachmentSchema = passwordPolicyAttachment.schema()
+
+// PasswordPolicyAttachment returns a pointer to the resource representing a password policy
+// attached to a user or the account.
+func PasswordPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: CreatePasswordPolicyAttachment,
+		Read:   ReadPasswordPolicyAttachment,
+		Delete: DeletePasswordPolicyAttachment,
+
+		Schema: passwordPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreatePasswordPolicyAttachment implements schema.CreateFunc.
+func CreatePasswordPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	return passwordPolicyAttachment.create(d, meta)
+}
+
+// ReadPasswordPolicyAttachment implements schema.ReadFunc.
+func ReadPasswordPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	return passwordPolicyAttachment.read(d, meta)
+}
+
+// DeletePasswordPolicyAttachment implements schema.DeleteFunc.
+func DeletePasswordPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	return passwordPolicyAttachment.delete(d, meta)
+}
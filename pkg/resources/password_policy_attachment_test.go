@@ -0,0 +1,78 @@
+package resources_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+const userPasswordPolicyReferences = `SELECT * FROM TABLE("pp_db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"user"', REF_ENTITY_DOMAIN => 'USER'))`
+
+func TestPasswordPolicyAttachment(t *testing.T) {
+	r := require.New(t)
+	err := resources.PasswordPolicyAttachment().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestPasswordPolicyAttachmentCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"password_policy": "pp_db|pp_schema|pp_name",
+		"object_type":     "USER",
+		"object_name":     "user",
+	}
+	d := schema.TestResourceDataRaw(t, resources.PasswordPolicyAttachment().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER USER "user" SET PASSWORD POLICY "pp_db"."pp_schema"."pp_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND"}).
+			AddRow("pp_db", "pp_schema", "pp_name", "PASSWORD_POLICY")
+		mock.ExpectQuery(regexp.QuoteMeta(userPasswordPolicyReferences)).WillReturnRows(rows)
+
+		err := resources.CreatePasswordPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Equal("USER|user|pp_db|pp_schema|pp_name", d.Id())
+		r.Equal("user", d.Get("object_name").(string))
+	})
+}
+
+func TestPasswordPolicyAttachmentReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.PasswordPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("USER|user|pp_db|pp_schema|pp_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// only a session policy is attached to the user
+		rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND"}).
+			AddRow("pp_db", "pp_schema", "pp_name", "SESSION_POLICY")
+		mock.ExpectQuery(regexp.QuoteMeta(userPasswordPolicyReferences)).WillReturnRows(rows)
+
+		err := resources.ReadPasswordPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func TestPasswordPolicyAttachmentDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.PasswordPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("ACCOUNT||pp_db|pp_schema|pp_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER ACCOUNT UNSET PASSWORD POLICY$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := resources.DeletePasswordPolicyAttachment(d, db)
+		r.NoError(err)
+	})
+}
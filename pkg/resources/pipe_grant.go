@@ -11,11 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validPipePrivileges = NewPrivilegeSet(
-	privilegeMonitor,
-	privilegeOperate,
-	privilegeOwnership,
-)
+var validPipePrivileges = privilegesFor("PIPE")
 
 var pipeGrantSchema = map[string]*schema.Schema{
 	"pipe_name": {
@@ -56,6 +52,7 @@ var pipeGrantSchema = map[string]*schema.Schema{
 		Description: "When this is set to true and a schema_name is provided, apply this grant on all future pipes in the given schema. When this is true and no schema_name is provided apply this grant on all future pipes in the given database. The pipe_name field must be unset in order to use on_future.",
 		Default:     false,
 	},
+	"revoke_existing_on_delete": revokeExistingOnDeleteSchema,
 	"with_grant_option": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -70,6 +67,10 @@ var pipeGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 }
 
 // PipeGrant returns a pointer to the resource representing a pipe grant.
@@ -81,9 +82,12 @@ func PipeGrant() *TerraformGrantResource {
 			Delete: DeletePipeGrant,
 			Update: UpdatePipeGrant,
 
-			Schema: pipeGrantSchema,
+			Schema:        pipeGrantSchema,
+			CustomizeDiff: validateBulkGrantSupport("PIPE"),
 			Importer: &schema.ResourceImporter{
-				StateContext: schema.ImportStatePassthroughContext,
+				StateContext: importFutureGrantWildcard(snowflake.FuturePipeGrant, func(g *futureGrantImport) string {
+					return NewPipeGrantID(g.DatabaseName, g.SchemaName, "", g.Privilege, g.Roles, g.WithGrantOption).String()
+				}),
 			},
 		},
 		ValidPrivs: validPipePrivileges,
@@ -223,13 +227,13 @@ func UpdatePipeGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, rolesToRevoke, []string{},
+		meta, d.Get("as_role").(string), builder, grantID.Privilege, rolesToRevoke, []string{},
 	); err != nil {
 		return err
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
+		meta, d.Get("as_role").(string), d.Get("current_grants").(string), builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
 	); err != nil {
 		return err
 	}
@@ -68,6 +68,27 @@ func TestPipeGrantRead(t *testing.T) {
 	r.Equal(2, roles.Len())
 }
 
+func TestPipeGrantDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := pipeGrant(t, "test-db❄️PUBLIC❄️test-pipe❄️MONITOR❄️false❄️test-role-1", map[string]interface{}{
+		"pipe_name":     "test-pipe",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "MONITOR",
+		"roles":         []interface{}{"test-role-1"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`^REVOKE MONITOR ON PIPE "test-db"."PUBLIC"."test-pipe" FROM ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		err := resources.DeletePipeGrant(d, db)
+		r.NoError(err)
+		r.Equal("", d.Id())
+	})
+}
+
 func expectReadPipeGrant(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{
 		"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
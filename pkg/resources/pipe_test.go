@@ -66,6 +66,28 @@ func TestPipeRead(t *testing.T) {
 	})
 }
 
+func TestPipeReadNotificationChannel(t *testing.T) {
+	r := require.New(t)
+
+	d := pipe(t, "test_db|test_schema|test_pipe", map[string]interface{}{
+		"name":     "test_pipe",
+		"database": "test_db",
+		"schema":   "test_schema",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "definition", "owner", "notification_channel", "comment", "error_integration",
+		}).AddRow("2019-12-23 17:20:50.088 +0000", "test_pipe", "test_db", "test_schema", "test definition", "N", "arn:aws:sqs:us-west-2:123456789012:sf-snowpipe-AIDA", "", nil)
+		mock.ExpectQuery(`^SHOW PIPES LIKE 'test_pipe' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+		err := resources.ReadPipe(d, db)
+		r.NoError(err)
+	})
+	r.Equal("arn:aws:sqs:us-west-2:123456789012:sf-snowpipe-AIDA", d.Get("notification_channel"))
+	r.True(d.Get("auto_ingest").(bool))
+	r.Empty(d.Get("aws_sns_topic_arn"))
+}
+
 func expectReadPipe(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{
 		"created_on", "name", "database_name", "schema_name", "definition", "owner", "notification_channel", "comment", "error_integration",
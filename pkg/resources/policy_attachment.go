@@ -0,0 +1,208 @@
+package resources
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
+)
+
+const policyAttachmentIDDelimiter = '|'
+
+// policyAttachment describes a resource attaching a policy of a given kind, e.g. SESSION, to a user,
+// a role or the account.
+type policyAttachment struct {
+	// policyKind is the kind of the policy as written in ALTER … SET <policyKind> POLICY.
+	policyKind string
+	// policyKey is the attribute holding the fully qualified name of the policy.
+	policyKey string
+	// objectTypes are the types of objects the policy can be attached to.
+	objectTypes []string
+}
+
+func (pa *policyAttachment) schema() map[string]*schema.Schema {
+	kind := strings.ToLower(pa.policyKind)
+	objectName := &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: fmt.Sprintf("The name of the object the %v policy is attached to.", kind),
+	}
+	if snowflake.Contains(pa.objectTypes, "ACCOUNT") {
+		objectName.Required = false
+		objectName.Optional = true
+		objectName.Description += " Required unless object_type is ACCOUNT, in which case the policy is attached to the current account."
+	}
+	return map[string]*schema.Schema{
+		pa.policyKey: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  fmt.Sprintf("The fully qualified name of the %v policy, either \"databaseName\".\"schemaName\".\"policyName\", databaseName.schemaName.policyName or databaseName|schemaName|policyName.", kind),
+			ValidateFunc: snowflakeValidation.ValidateFullyQualifiedObjectID,
+		},
+		"object_type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  fmt.Sprintf("The type of the object the %v policy is attached to, one of %v.", kind, strings.Join(pa.objectTypes, ", ")),
+			ValidateFunc: validation.StringInSlice(pa.objectTypes, false),
+		},
+		"object_name": objectName,
+	}
+}
+
+type policyAttachmentID struct {
+	ObjectType         string
+	ObjectName         string
+	PolicyDatabaseName string
+	PolicySchemaName   string
+	PolicyName         string
+}
+
+// String() takes in a policyAttachmentID object and returns a pipe-delimited string:
+// ObjectType|ObjectName|PolicyDatabaseName|PolicySchemaName|PolicyName.
+func (id *policyAttachmentID) String() (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = policyAttachmentIDDelimiter
+	dataIdentifiers := [][]string{{id.ObjectType, id.ObjectName, id.PolicyDatabaseName, id.PolicySchemaName, id.PolicyName}}
+	if err := csvWriter.WriteAll(dataIdentifiers); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// policyAttachmentIDFromString() takes in a pipe-delimited string:
+// ObjectType|ObjectName|PolicyDatabaseName|PolicySchemaName|PolicyName
+// and returns a policyAttachmentID object.
+func policyAttachmentIDFromString(stringID string) (*policyAttachmentID, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = policyAttachmentIDDelimiter
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("not CSV compatible")
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("1 line per policy attachment")
+	}
+	if len(lines[0]) != 5 {
+		return nil, fmt.Errorf("5 fields allowed")
+	}
+
+	return &policyAttachmentID{
+		ObjectType:         lines[0][0],
+		ObjectName:         lines[0][1],
+		PolicyDatabaseName: lines[0][2],
+		PolicySchemaName:   lines[0][3],
+		PolicyName:         lines[0][4],
+	}, nil
+}
+
+func (pa *policyAttachment) builder(id *policyAttachmentID) *snowflake.PolicyAttachmentBuilder {
+	policy := fmt.Sprintf(`"%v"."%v"."%v"`, id.PolicyDatabaseName, id.PolicySchemaName, id.PolicyName)
+	return snowflake.PolicyAttachment(pa.policyKind, id.ObjectType, id.ObjectName).WithPolicy(policy)
+}
+
+func (pa *policyAttachment) create(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	policyDB, policySchema, policyName := snowflakeValidation.ParseFullyQualifiedObjectID(d.Get(pa.policyKey).(string))
+	id := &policyAttachmentID{
+		ObjectType:         d.Get("object_type").(string),
+		ObjectName:         d.Get("object_name").(string),
+		PolicyDatabaseName: policyDB,
+		PolicySchemaName:   policySchema,
+		PolicyName:         policyName,
+	}
+	if id.ObjectType == "ACCOUNT" {
+		id.ObjectName = ""
+	} else if id.ObjectName == "" {
+		return fmt.Errorf("object_name is required when object_type is %v", id.ObjectType)
+	}
+
+	if err := snowflake.Exec(db, pa.builder(id).Set()); err != nil {
+		return fmt.Errorf("error setting %v policy %v on %v %v err = %w", strings.ToLower(pa.policyKind), policyName, strings.ToLower(id.ObjectType), id.ObjectName, err)
+	}
+
+	idString, err := id.String()
+	if err != nil {
+		return err
+	}
+	d.SetId(idString)
+
+	return pa.read(d, meta)
+}
+
+func (pa *policyAttachment) read(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	id, err := policyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	builder := pa.builder(id)
+	references, err := snowflake.ListPolicyReferences(builder.Show(id.PolicyDatabaseName), db)
+	if err != nil {
+		if isObjectNotExistError(err) {
+			log.Printf("[DEBUG] %v of %v policy attachment (%s) not found", strings.ToLower(id.ObjectType), strings.ToLower(pa.policyKind), d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	found := false
+	for _, reference := range references {
+		if reference.PolicyKind.String == builder.PolicyKind() &&
+			reference.PolicyDB.String == id.PolicyDatabaseName &&
+			reference.PolicySchema.String == id.PolicySchemaName &&
+			reference.PolicyName.String == id.PolicyName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		log.Printf("[DEBUG] %v policy attachment (%s) not found", strings.ToLower(pa.policyKind), d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("object_type", id.ObjectType); err != nil {
+		return err
+	}
+	if id.ObjectName != "" {
+		if err := d.Set("object_name", id.ObjectName); err != nil {
+			return err
+		}
+	}
+	// keep the format the policy name was configured in
+	policyDB, policySchema, policyName := snowflakeValidation.ParseFullyQualifiedObjectID(d.Get(pa.policyKey).(string))
+	if policyDB != id.PolicyDatabaseName || policySchema != id.PolicySchemaName || policyName != id.PolicyName {
+		return d.Set(pa.policyKey, fmt.Sprintf("%v|%v|%v", id.PolicyDatabaseName, id.PolicySchemaName, id.PolicyName))
+	}
+	return nil
+}
+
+func (pa *policyAttachment) delete(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	id, err := policyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := snowflake.Exec(db, pa.builder(id).Unset()); err != nil {
+		return fmt.Errorf("error unsetting %v policy %v from %v %v err = %w", strings.ToLower(pa.policyKind), id.PolicyName, strings.ToLower(id.ObjectType), id.ObjectName, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -1,5 +1,7 @@
 package resources
 
+//go:generate go run ../../tools/privileges -in privileges.csv -out privileges_generated.go
+
 type Privilege string
 
 func (p Privilege) String() string {
@@ -7,9 +9,11 @@ func (p Privilege) String() string {
 }
 
 const (
+	privilegeAllPrivileges               Privilege = "ALL PRIVILEGES"
 	privilegeAccountSupportCases         Privilege = "MANAGE ACCOUNT SUPPORT CASES"
 	privilegeAddSearchOptimization       Privilege = "ADD SEARCH OPTIMIZATION"
 	privilegeApply                       Privilege = "APPLY"
+	privilegeApplyBudget                 Privilege = "APPLYBUDGET"
 	privilegeApplyMaskingPolicy          Privilege = "APPLY MASKING POLICY"
 	privilegeApplyPasswordPolicy         Privilege = "APPLY PASSWORD POLICY"
 	privilegeApplyRowAccessPolicy        Privilege = "APPLY ROW ACCESS POLICY"
@@ -102,3 +106,38 @@ func (ps PrivilegeSet) hasString(s string) bool {
 	_, ok := ps[Privilege(s)]
 	return ok
 }
+
+// grantedByAll reports whether ps holds every privilege of valid that ALL PRIVILEGES grants, that is
+// all of them but OWNERSHIP. SHOW GRANTS reports the individual privileges of such a grant.
+func (ps PrivilegeSet) grantedByAll(valid PrivilegeSet) bool {
+	found := false
+	for p := range valid {
+		if p == privilegeOwnership || p == privilegeAllPrivileges {
+			continue
+		}
+		if _, ok := ps[p]; !ok {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
+// withAllPrivileges returns a copy of ps that also accepts ALL PRIVILEGES.
+func (ps PrivilegeSet) withAllPrivileges() PrivilegeSet {
+	all := NewPrivilegeSet(privilegeAllPrivileges)
+	for p := range ps {
+		all[p] = struct{}{}
+	}
+	return all
+}
+
+// privilegesFor returns the privileges that can be granted on objectType, as listed in
+// privileges.csv. It panics if the object type is missing so that a typo fails at init.
+func privilegesFor(objectType string) PrivilegeSet {
+	ps, ok := objectPrivileges[objectType]
+	if !ok {
+		panic("no privileges listed for object type " + objectType)
+	}
+	return ps
+}
@@ -0,0 +1,177 @@
+// Code generated by tools/privileges from privileges.csv; DO NOT EDIT.
+
+package resources
+
+// objectPrivileges holds the privileges that can be granted on each object type.
+var objectPrivileges = map[string]PrivilegeSet{
+	"ACCOUNT": NewPrivilegeSet(
+		"APPLY MASKING POLICY",
+		"APPLY PASSWORD POLICY",
+		"APPLY ROW ACCESS POLICY",
+		"APPLY SESSION POLICY",
+		"APPLY TAG",
+		"ATTACH POLICY",
+		"AUDIT",
+		"CREATE ACCOUNT",
+		"CREATE CREDENTIAL",
+		"CREATE DATA EXCHANGE LISTING",
+		"CREATE DATABASE",
+		"CREATE FAILOVER GROUP",
+		"CREATE INTEGRATION",
+		"CREATE NETWORK POLICY",
+		"CREATE ROLE",
+		"CREATE SHARE",
+		"CREATE USER",
+		"CREATE WAREHOUSE",
+		"EXECUTE MANAGED TASK",
+		"EXECUTE TASK",
+		"IMPORT SHARE",
+		"MANAGE ACCOUNT SUPPORT CASES",
+		"MANAGE GRANTS",
+		"MANAGE ORGANIZATION SUPPORT CASES",
+		"MANAGE USER SUPPORT CASES",
+		"MONITOR",
+		"MONITOR EXECUTION",
+		"MONITOR SECURITY",
+		"MONITOR USAGE",
+		"OVERRIDE SHARE RESTRICTIONS",
+		"PROVISION APPLICATION",
+		"PURCHASE DATA EXCHANGE LISTING",
+	),
+	"ALERT": NewPrivilegeSet(
+		"OPERATE",
+		"OWNERSHIP",
+	),
+	"DATABASE": NewPrivilegeSet(
+		"APPLYBUDGET",
+		"CREATE SCHEMA",
+		"IMPORTED PRIVILEGES",
+		"MODIFY",
+		"MONITOR",
+		"OWNERSHIP",
+		"REFERENCE_USAGE",
+		"USAGE",
+	),
+	"EXTERNAL TABLE": NewPrivilegeSet(
+		"OWNERSHIP",
+		"REFERENCES",
+		"SELECT",
+	),
+	"FILE FORMAT": NewPrivilegeSet(
+		"OWNERSHIP",
+		"USAGE",
+	),
+	"FUNCTION": NewPrivilegeSet(
+		"OWNERSHIP",
+		"USAGE",
+	),
+	"INTEGRATION": NewPrivilegeSet(
+		"OWNERSHIP",
+		"USAGE",
+	),
+	"MASKING POLICY": NewPrivilegeSet(
+		"APPLY",
+		"OWNERSHIP",
+	),
+	"MATERIALIZED VIEW": NewPrivilegeSet(
+		"APPLYBUDGET",
+		"OWNERSHIP",
+		"REFERENCES",
+		"SELECT",
+	),
+	"PIPE": NewPrivilegeSet(
+		"APPLYBUDGET",
+		"MONITOR",
+		"OPERATE",
+		"OWNERSHIP",
+	),
+	"PROCEDURE": NewPrivilegeSet(
+		"OWNERSHIP",
+		"USAGE",
+	),
+	"RESOURCE MONITOR": NewPrivilegeSet(
+		"MODIFY",
+		"MONITOR",
+	),
+	"ROW ACCESS POLICY": NewPrivilegeSet(
+		"APPLY",
+		"OWNERSHIP",
+	),
+	"SCHEMA": NewPrivilegeSet(
+		"ADD SEARCH OPTIMIZATION",
+		"APPLYBUDGET",
+		"CREATE EXTERNAL TABLE",
+		"CREATE FILE FORMAT",
+		"CREATE FUNCTION",
+		"CREATE MASKING POLICY",
+		"CREATE MATERIALIZED VIEW",
+		"CREATE PIPE",
+		"CREATE PROCEDURE",
+		"CREATE ROW ACCESS POLICY",
+		"CREATE SEQUENCE",
+		"CREATE SESSION POLICY",
+		"CREATE STAGE",
+		"CREATE STREAM",
+		"CREATE TABLE",
+		"CREATE TAG",
+		"CREATE TASK",
+		"CREATE TEMPORARY TABLE",
+		"CREATE VIEW",
+		"MODIFY",
+		"MONITOR",
+		"OWNERSHIP",
+		"USAGE",
+	),
+	"SEQUENCE": NewPrivilegeSet(
+		"OWNERSHIP",
+		"USAGE",
+	),
+	"STAGE": NewPrivilegeSet(
+		"OWNERSHIP",
+		"READ",
+		"USAGE",
+		"WRITE",
+	),
+	"STREAM": NewPrivilegeSet(
+		"OWNERSHIP",
+		"SELECT",
+	),
+	"TABLE": NewPrivilegeSet(
+		"APPLYBUDGET",
+		"DELETE",
+		"INSERT",
+		"OWNERSHIP",
+		"REBUILD",
+		"REFERENCES",
+		"SELECT",
+		"TRUNCATE",
+		"UPDATE",
+	),
+	"TAG": NewPrivilegeSet(
+		"APPLY",
+		"OWNERSHIP",
+	),
+	"TASK": NewPrivilegeSet(
+		"APPLYBUDGET",
+		"MONITOR",
+		"OPERATE",
+		"OWNERSHIP",
+	),
+	"USER": NewPrivilegeSet(
+		"MONITOR",
+		"OWNERSHIP",
+	),
+	"VIEW": NewPrivilegeSet(
+		"OWNERSHIP",
+		"REFERENCES",
+		"SELECT",
+	),
+	"WAREHOUSE": NewPrivilegeSet(
+		"APPLYBUDGET",
+		"MODIFY",
+		"MONITOR",
+		"OPERATE",
+		"OWNERSHIP",
+		"USAGE",
+	),
+}
@@ -7,14 +7,12 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validProcedurePrivileges = NewPrivilegeSet(
-	privilegeOwnership,
-	privilegeUsage,
-)
+var validProcedurePrivileges = privilegesFor("PROCEDURE")
 
 var procedureGrantSchema = map[string]*schema.Schema{
 	"arguments": {
@@ -58,6 +56,10 @@ var procedureGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 	"on_future": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -65,6 +67,7 @@ var procedureGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"revoke_existing_on_delete": revokeExistingOnDeleteSchema,
 	"privilege": {
 		Type:         schema.TypeString,
 		Optional:     true,
@@ -100,7 +103,7 @@ var procedureGrantSchema = map[string]*schema.Schema{
 	},
 	"shares": {
 		Type:        schema.TypeSet,
-		Elem:        &schema.Schema{Type: schema.TypeString},
+		Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: snowflakeValidation.ValidateShareName},
 		Optional:    true,
 		Description: "Grants privilege to these shares (only valid if on_future is false).",
 	},
@@ -122,9 +125,12 @@ func ProcedureGrant() *TerraformGrantResource {
 			Delete: DeleteProcedureGrant,
 			Update: UpdateProcedureGrant,
 
-			Schema: procedureGrantSchema,
+			Schema:        procedureGrantSchema,
+			CustomizeDiff: validateBulkGrantSupport("PROCEDURE"),
 			Importer: &schema.ResourceImporter{
-				StateContext: schema.ImportStatePassthroughContext,
+				StateContext: importFutureGrantWildcard(snowflake.FutureProcedureGrant, func(g *futureGrantImport) string {
+					return NewProcedureGrantID(g.DatabaseName, g.SchemaName, "", []string{}, g.Privilege, g.Roles, []string{}, g.WithGrantOption).String()
+				}),
 			},
 		},
 		ValidPrivs: validProcedurePrivileges,
@@ -168,6 +174,13 @@ func CreateProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 	if (schemaName == "") && !onFuture {
 		return errors.New("schema_name must be set unless on_future is true")
 	}
+	// the argument data types tell overloaded procedures apart, so they only make sense with a procedure
+	if len(argumentDataTypes) > 0 && onFuture {
+		return errors.New("argument_data_types and arguments must be empty if on_future is true")
+	}
+	if len(shares) > 0 && onFuture {
+		return errors.New("shares must be empty if on_future is true")
+	}
 
 	var builder snowflake.GrantBuilder
 	if onFuture {
@@ -297,13 +310,13 @@ func UpdateProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, rolesToRevoke, sharesToRevoke,
+		meta, d.Get("as_role").(string), builder, grantID.Privilege, rolesToRevoke, sharesToRevoke,
 	); err != nil {
 		return err
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, sharesToAdd,
+		meta, d.Get("as_role").(string), d.Get("current_grants").(string), builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, sharesToAdd,
 	); err != nil {
 		return err
 	}
@@ -350,13 +363,13 @@ func ParseProcedureGrantID(s string) (*ProcedureGrantID, error) {
 	if !strings.Contains(s, "❄️") {
 		idParts := strings.Split(s, "|")
 		objectIdentifier := idParts[2]
-		if idx := strings.Index(objectIdentifier, ")"); idx != -1 {
+		if idx := strings.LastIndex(objectIdentifier, ")"); idx != -1 {
 			objectIdentifier = objectIdentifier[0:idx]
 		}
-		objectNameParts := strings.Split(objectIdentifier, "(")
+		objectNameParts := strings.SplitN(objectIdentifier, "(", 2)
 		argumentDataTypes := []string{}
 		if len(objectNameParts) > 1 {
-			argumentDataTypes = helpers.SplitStringToSlice(objectNameParts[1], ",")
+			argumentDataTypes = splitArgumentDataTypes(objectNameParts[1])
 		}
 		return &ProcedureGrantID{
 			DatabaseName:      idParts[0],
@@ -378,7 +391,7 @@ func ParseProcedureGrantID(s string) (*ProcedureGrantID, error) {
 		DatabaseName:      idParts[0],
 		SchemaName:        idParts[1],
 		ObjectName:        idParts[2],
-		ArgumentDataTypes: helpers.SplitStringToSlice(idParts[3], ","),
+		ArgumentDataTypes: splitArgumentDataTypes(idParts[3]),
 		Privilege:         idParts[4],
 		WithGrantOption:   idParts[5] == "true",
 		Roles:             helpers.SplitStringToSlice(idParts[6], ","),
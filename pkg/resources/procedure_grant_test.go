@@ -1,9 +1,14 @@
 package resources_test
 
 import (
+	"database/sql"
 	"testing"
+	"time"
 
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/require"
 )
 
@@ -62,3 +67,66 @@ func TestParseProcedureGrantOldIDWithArgs(t *testing.T) {
 	r.Equal(0, len(grantID.Shares))
 	r.Equal(false, grantID.WithGrantOption)
 }
+
+func TestProcedureGrantIDRoundTripWithPrecisionArgs(t *testing.T) {
+	r := require.New(t)
+	argumentDataTypes := []string{"NUMBER(38,0)", "ARRAY", "VARCHAR(10)"}
+	id := resources.NewProcedureGrantID("MY_DATABASE", "MY_SCHEMA", "MY_PROCEDURE", argumentDataTypes, "USAGE", []string{"role1"}, []string{}, false)
+
+	grantID, err := resources.ParseProcedureGrantID(id.String())
+	r.NoError(err)
+	r.Equal("MY_PROCEDURE", grantID.ObjectName)
+	r.Equal(argumentDataTypes, grantID.ArgumentDataTypes)
+	r.Equal("USAGE", grantID.Privilege)
+	r.Equal([]string{"role1"}, grantID.Roles)
+
+	grantID, err = resources.ParseProcedureGrantID("MY_DATABASE|MY_SCHEMA|MY_PROCEDURE(NUMBER(38,0), ARRAY)|USAGE|role1|false")
+	r.NoError(err)
+	r.Equal("MY_PROCEDURE", grantID.ObjectName)
+	r.Equal([]string{"NUMBER(38,0)", "ARRAY"}, grantID.ArgumentDataTypes)
+}
+
+func TestProcedureGrantCreateUsage(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"procedure_name":      "test-procedure",
+		"argument_data_types": []interface{}{"NUMBER"},
+		"schema_name":         "PUBLIC",
+		"database_name":       "test-db",
+		"privilege":           "USAGE",
+		"roles":               []interface{}{"test-role-1"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.ProcedureGrant().Resource.Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^GRANT USAGE ON PROCEDURE "test-db"."PUBLIC"."test-procedure"\(NUMBER\) TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{
+			"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+		}).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "USAGE", "PROCEDURE", `"test-db"."PUBLIC"."test-procedure"(NUMBER)`, "ROLE", "test-role-1", false, "bob",
+		)
+		mock.ExpectQuery(`^SHOW GRANTS ON PROCEDURE "test-db"."PUBLIC"."test-procedure"\(NUMBER\)$`).WillReturnRows(rows)
+		err := resources.CreateProcedureGrant(d, db)
+		r.NoError(err)
+	})
+	r.Equal("test-db❄️PUBLIC❄️test-procedure❄️NUMBER❄️USAGE❄️false❄️test-role-1❄️", d.Id())
+}
+
+func TestFutureProcedureGrantCreateRejectsArguments(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"on_future":           true,
+		"argument_data_types": []interface{}{"STRING"},
+		"database_name":       "test-db",
+		"privilege":           "USAGE",
+		"roles":               []interface{}{"test-role-1"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.ProcedureGrant().Resource.Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		err := resources.CreateProcedureGrant(d, db)
+		r.EqualError(err, "argument_data_types and arguments must be empty if on_future is true")
+	})
+}
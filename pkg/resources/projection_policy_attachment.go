@@ -0,0 +1,225 @@
+package resources
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
+)
+
+const (
+	projectionPolicyAttachmentIDDelimiter = '|'
+	projectionPolicyKind                  = "PROJECTION_POLICY"
+)
+
+var projectionPolicyAttachmentSchema = map[string]*schema.Schema{
+	"projection_policy": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "The fully qualified name of the projection policy, either \"databaseName\".\"schemaName\".\"policyName\", databaseName.schemaName.policyName or databaseName|schemaName|policyName.",
+		ValidateFunc: snowflakeValidation.ValidateFullyQualifiedObjectID,
+	},
+	"entity_type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "The type of the object whose column the projection policy is attached to, one of TABLE, VIEW.",
+		ValidateFunc: validation.StringInSlice([]string{"TABLE", "VIEW"}, false),
+	},
+	"entity_name": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "The fully qualified name of the table or view, either \"databaseName\".\"schemaName\".\"objectName\", databaseName.schemaName.objectName or databaseName|schemaName|objectName.",
+		ValidateFunc: snowflakeValidation.ValidateFullyQualifiedObjectID,
+	},
+	"column_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the column the projection policy is attached to.",
+	},
+}
+
+// ProjectionPolicyAttachment returns a pointer to the resource representing a projection policy
+// attached to a column of a table or view.
+func ProjectionPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateProjectionPolicyAttachment,
+		Read:   ReadProjectionPolicyAttachment,
+		Delete: DeleteProjectionPolicyAttachment,
+
+		Schema: projectionPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+type projectionPolicyAttachmentID struct {
+	EntityType         string
+	DatabaseName       string
+	SchemaName         string
+	EntityName         string
+	ColumnName         string
+	PolicyDatabaseName string
+	PolicySchemaName   string
+	PolicyName         string
+}
+
+// String() takes in a projectionPolicyAttachmentID object and returns a pipe-delimited string:
+// EntityType|DatabaseName|SchemaName|EntityName|ColumnName|PolicyDatabaseName|PolicySchemaName|PolicyName.
+func (id *projectionPolicyAttachmentID) String() (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = projectionPolicyAttachmentIDDelimiter
+	dataIdentifiers := [][]string{{id.EntityType, id.DatabaseName, id.SchemaName, id.EntityName, id.ColumnName, id.PolicyDatabaseName, id.PolicySchemaName, id.PolicyName}}
+	if err := csvWriter.WriteAll(dataIdentifiers); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// projectionPolicyAttachmentIDFromString() takes in a pipe-delimited string:
+// EntityType|DatabaseName|SchemaName|EntityName|ColumnName|PolicyDatabaseName|PolicySchemaName|PolicyName
+// and returns a projectionPolicyAttachmentID object.
+func projectionPolicyAttachmentIDFromString(stringID string) (*projectionPolicyAttachmentID, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = projectionPolicyAttachmentIDDelimiter
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("not CSV compatible")
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("1 line per projection policy attachment")
+	}
+	if len(lines[0]) != 8 {
+		return nil, fmt.Errorf("8 fields allowed")
+	}
+
+	return &projectionPolicyAttachmentID{
+		EntityType:         lines[0][0],
+		DatabaseName:       lines[0][1],
+		SchemaName:         lines[0][2],
+		EntityName:         lines[0][3],
+		ColumnName:         lines[0][4],
+		PolicyDatabaseName: lines[0][5],
+		PolicySchemaName:   lines[0][6],
+		PolicyName:         lines[0][7],
+	}, nil
+}
+
+func (id *projectionPolicyAttachmentID) builder() *snowflake.ProjectionPolicyAttachmentBuilder {
+	policy := fmt.Sprintf(`"%v"."%v"."%v"`, id.PolicyDatabaseName, id.PolicySchemaName, id.PolicyName)
+	return snowflake.ProjectionPolicyAttachment(id.EntityType, id.DatabaseName, id.SchemaName, id.EntityName, id.ColumnName).WithPolicy(policy)
+}
+
+// CreateProjectionPolicyAttachment implements schema.CreateFunc.
+func CreateProjectionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	entityDB, entitySchema, entityName := snowflakeValidation.ParseFullyQualifiedObjectID(d.Get("entity_name").(string))
+	policyDB, policySchema, policyName := snowflakeValidation.ParseFullyQualifiedObjectID(d.Get("projection_policy").(string))
+	id := &projectionPolicyAttachmentID{
+		EntityType:         d.Get("entity_type").(string),
+		DatabaseName:       entityDB,
+		SchemaName:         entitySchema,
+		EntityName:         entityName,
+		ColumnName:         d.Get("column_name").(string),
+		PolicyDatabaseName: policyDB,
+		PolicySchemaName:   policySchema,
+		PolicyName:         policyName,
+	}
+
+	if err := snowflake.Exec(db, id.builder().Set()); err != nil {
+		return fmt.Errorf("error setting projection policy %v on column %v of %v %v err = %w", policyName, id.ColumnName, strings.ToLower(id.EntityType), entityName, err)
+	}
+
+	idString, err := id.String()
+	if err != nil {
+		return err
+	}
+	d.SetId(idString)
+
+	return ReadProjectionPolicyAttachment(d, meta)
+}
+
+// ReadProjectionPolicyAttachment implements schema.ReadFunc.
+func ReadProjectionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	id, err := projectionPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	references, err := snowflake.ListPolicyReferences(id.builder().Show(), db)
+	if err != nil {
+		if isObjectNotExistError(err) {
+			log.Printf("[DEBUG] %v of projection policy attachment (%s) not found", strings.ToLower(id.EntityType), d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	found := false
+	for _, reference := range references {
+		if reference.PolicyKind.String == projectionPolicyKind &&
+			reference.RefColumnName.String == id.ColumnName &&
+			reference.PolicyDB.String == id.PolicyDatabaseName &&
+			reference.PolicySchema.String == id.PolicySchemaName &&
+			reference.PolicyName.String == id.PolicyName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		log.Printf("[DEBUG] projection policy attachment (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("entity_type", id.EntityType); err != nil {
+		return err
+	}
+	if err := d.Set("column_name", id.ColumnName); err != nil {
+		return err
+	}
+	// keep the format the entity and policy names were configured in
+	entityDB, entitySchema, entityName := snowflakeValidation.ParseFullyQualifiedObjectID(d.Get("entity_name").(string))
+	if entityDB != id.DatabaseName || entitySchema != id.SchemaName || entityName != id.EntityName {
+		if err := d.Set("entity_name", fmt.Sprintf("%v|%v|%v", id.DatabaseName, id.SchemaName, id.EntityName)); err != nil {
+			return err
+		}
+	}
+	policyDB, policySchema, policyName := snowflakeValidation.ParseFullyQualifiedObjectID(d.Get("projection_policy").(string))
+	if policyDB != id.PolicyDatabaseName || policySchema != id.PolicySchemaName || policyName != id.PolicyName {
+		return d.Set("projection_policy", fmt.Sprintf("%v|%v|%v", id.PolicyDatabaseName, id.PolicySchemaName, id.PolicyName))
+	}
+	return nil
+}
+
+// DeleteProjectionPolicyAttachment implements schema.DeleteFunc.
+func DeleteProjectionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	id, err := projectionPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := snowflake.Exec(db, id.builder().Unset()); err != nil {
+		return fmt.Errorf("error unsetting projection policy %v from column %v of %v %v err = %w", id.PolicyName, id.ColumnName, strings.ToLower(id.EntityType), id.EntityName, err)
+	}
+
+	d.SetId("")
+	return nil
+}
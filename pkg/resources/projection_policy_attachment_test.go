@@ -0,0 +1,100 @@
+package resources_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+const tableProjectionPolicyReferences = `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"db"."schema"."table"', REF_ENTITY_DOMAIN => 'TABLE'))`
+
+func TestProjectionPolicyAttachment(t *testing.T) {
+	r := require.New(t)
+	err := resources.ProjectionPolicyAttachment().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestProjectionPolicyAttachmentCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"projection_policy": "pp_db|pp_schema|pp_name",
+		"entity_type":       "TABLE",
+		"entity_name":       `"db"."schema"."table"`,
+		"column_name":       "email",
+	}
+	d := schema.TestResourceDataRaw(t, resources.ProjectionPolicyAttachment().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER TABLE "db"."schema"."table" ALTER COLUMN "email" SET PROJECTION POLICY "pp_db"."pp_schema"."pp_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadProjectionPolicyAttachment(mock)
+
+		err := resources.CreateProjectionPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Equal("TABLE|db|schema|table|email|pp_db|pp_schema|pp_name", d.Id())
+		r.Equal(`"db"."schema"."table"`, d.Get("entity_name").(string))
+	})
+}
+
+func TestProjectionPolicyAttachmentRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.ProjectionPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("TABLE|db|schema|table|email|pp_db|pp_schema|pp_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadProjectionPolicyAttachment(mock)
+
+		err := resources.ReadProjectionPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Equal("TABLE", d.Get("entity_type").(string))
+		r.Equal("db|schema|table", d.Get("entity_name").(string))
+		r.Equal("email", d.Get("column_name").(string))
+		r.Equal("pp_db|pp_schema|pp_name", d.Get("projection_policy").(string))
+	})
+}
+
+func TestProjectionPolicyAttachmentReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.ProjectionPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("TABLE|db|schema|table|phone|pp_db|pp_schema|pp_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// the policy is only attached to another column
+		expectReadProjectionPolicyAttachment(mock)
+
+		err := resources.ReadProjectionPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func TestProjectionPolicyAttachmentDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.ProjectionPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("VIEW|db|schema|view|email|pp_db|pp_schema|pp_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER VIEW "db"."schema"."view" ALTER COLUMN "email" UNSET PROJECTION POLICY$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := resources.DeleteProjectionPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func expectReadProjectionPolicyAttachment(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND", "REF_COLUMN_NAME"}).
+		AddRow("pp_db", "pp_schema", "pp_name", "PROJECTION_POLICY", "email")
+	mock.ExpectQuery(regexp.QuoteMeta(tableProjectionPolicyReferences)).WillReturnRows(rows)
+}
@@ -10,10 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validResourceMonitorPrivileges = NewPrivilegeSet(
-	privilegeModify,
-	privilegeMonitor,
-)
+var validResourceMonitorPrivileges = privilegesFor("RESOURCE MONITOR")
 
 var resourceMonitorGrantSchema = map[string]*schema.Schema{
 	"monitor_name": {
@@ -50,6 +47,10 @@ var resourceMonitorGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 }
 
 // ResourceMonitorGrant returns a pointer to the resource representing a resource monitor grant.
@@ -147,13 +148,13 @@ func UpdateResourceMonitorGrant(d *schema.ResourceData, meta interface{}) error
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, rolesToRevoke, []string{},
+		meta, d.Get("as_role").(string), builder, grantID.Privilege, rolesToRevoke, []string{},
 	); err != nil {
 		return err
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
+		meta, d.Get("as_role").(string), d.Get("current_grants").(string), builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
 	); err != nil {
 		return err
 	}
@@ -120,6 +120,8 @@ func ReadRoleGrants(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	// only the grantees tracked in the ID are read, so memberships granted outside Terraform never
+	// enter the state and are never revoked
 	for _, grant := range grants {
 		switch grant.GrantedTo.String {
 		case "ROLE":
@@ -290,6 +292,13 @@ func UpdateRoleGrants(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	// track the grantees now managed, otherwise the ones just granted are dropped by the read and
+	// the ones just revoked are still looked for
+	roles := expandStringList(d.Get("roles").(*schema.Set).List())
+	users := expandStringList(d.Get("users").(*schema.Set).List())
+	grantID := NewRoleGrantsID(roleName, roles, users)
+	d.SetId(grantID.String())
+
 	return ReadRoleGrants(d, meta)
 }
 
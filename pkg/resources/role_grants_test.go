@@ -125,3 +125,42 @@ func TestIgnoreUnknownRoleGrants(t *testing.T) {
 		r.Len(d.Get("roles").(*schema.Set).List(), 2)
 	})
 }
+
+func TestRoleGrantsUpdateKeepsExternalGrants(t *testing.T) {
+	r := require.New(t)
+
+	d := roleGrantsUpdate(t, "good_name❄️role1❄️user1", map[string]interface{}{
+		"role_name":              "good_name",
+		"roles":                  []interface{}{"role1"},
+		"users":                  []interface{}{"user1"},
+		"enable_multiple_grants": true,
+	}, map[string]interface{}{
+		"role_name":              "good_name",
+		"roles":                  []interface{}{"role2"},
+		"users":                  []interface{}{"user1"},
+		"enable_multiple_grants": true,
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// external_role and external_user were granted outside Terraform and must not be revoked
+		mock.ExpectExec(`^REVOKE ROLE "good_name" FROM ROLE "role1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^GRANT ROLE "good_name" TO ROLE "role2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{
+			"created_on",
+			"role",
+			"granted_to",
+			"grantee_name",
+			"granted_by",
+		}).
+			AddRow("_", "good_name", "ROLE", "role2", "").
+			AddRow("_", "good_name", "ROLE", "external_role", "").
+			AddRow("_", "good_name", "USER", "user1", "").
+			AddRow("_", "good_name", "USER", "external_user", "")
+		mock.ExpectQuery(`^SHOW GRANTS OF ROLE "good_name"$`).WillReturnRows(rows)
+		err := resources.UpdateRoleGrants(d, db)
+		r.NoError(err)
+	})
+	r.Equal("good_name❄️role2❄️user1", d.Id())
+	r.Equal([]interface{}{"role2"}, d.Get("roles").(*schema.Set).List())
+	r.Equal([]interface{}{"user1"}, d.Get("users").(*schema.Set).List())
+}
@@ -0,0 +1,207 @@
+package resources
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
+)
+
+const (
+	rowAccessPolicyAttachmentIDDelimiter = '|'
+	rowAccessPolicyKind                  = "ROW_ACCESS_POLICY"
+)
+
+// rowAccessPolicyAttachmentSchema returns the schema of the resource attaching a row access policy
+// to an object named by the objectKey attribute, e.g. table_name.
+func rowAccessPolicyAttachmentSchema(objectType string, objectKey string) map[string]*schema.Schema {
+	objectType = strings.ToLower(objectType)
+	return map[string]*schema.Schema{
+		"database_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("The database of the %v.", objectType),
+		},
+		"schema_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("The schema of the %v.", objectType),
+		},
+		objectKey: {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("The name of the %v the row access policy is attached to.", objectType),
+		},
+		"row_access_policy_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "The fully qualified name of the row access policy, either \"databaseName\".\"schemaName\".\"policyName\", databaseName.schemaName.policyName or databaseName|schemaName|policyName (snowflake_row_access_policy.policy.id).",
+			ValidateFunc: snowflakeValidation.ValidateFullyQualifiedObjectID,
+		},
+		"argument": {
+			Type:        schema.TypeList,
+			Required:    true,
+			ForceNew:    true,
+			MinItems:    1,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: fmt.Sprintf("The columns of the %v passed to the row access policy, in the order of its signature.", objectType),
+		},
+	}
+}
+
+type rowAccessPolicyAttachmentID struct {
+	DatabaseName       string
+	SchemaName         string
+	ObjectName         string
+	PolicyDatabaseName string
+	PolicySchemaName   string
+	PolicyName         string
+}
+
+// String() takes in a rowAccessPolicyAttachmentID object and returns a pipe-delimited string:
+// DatabaseName|SchemaName|ObjectName|PolicyDatabaseName|PolicySchemaName|PolicyName.
+func (id *rowAccessPolicyAttachmentID) String() (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = rowAccessPolicyAttachmentIDDelimiter
+	dataIdentifiers := [][]string{{id.DatabaseName, id.SchemaName, id.ObjectName, id.PolicyDatabaseName, id.PolicySchemaName, id.PolicyName}}
+	if err := csvWriter.WriteAll(dataIdentifiers); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// rowAccessPolicyAttachmentIDFromString() takes in a pipe-delimited string:
+// DatabaseName|SchemaName|ObjectName|PolicyDatabaseName|PolicySchemaName|PolicyName
+// and returns a rowAccessPolicyAttachmentID object.
+func rowAccessPolicyAttachmentIDFromString(stringID string) (*rowAccessPolicyAttachmentID, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = rowAccessPolicyAttachmentIDDelimiter
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("not CSV compatible")
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("1 line per row access policy attachment")
+	}
+	if len(lines[0]) != 6 {
+		return nil, fmt.Errorf("6 fields allowed")
+	}
+
+	return &rowAccessPolicyAttachmentID{
+		DatabaseName:       lines[0][0],
+		SchemaName:         lines[0][1],
+		ObjectName:         lines[0][2],
+		PolicyDatabaseName: lines[0][3],
+		PolicySchemaName:   lines[0][4],
+		PolicyName:         lines[0][5],
+	}, nil
+}
+
+func (id *rowAccessPolicyAttachmentID) builder(objectType string) *snowflake.RowAccessPolicyAttachmentBuilder {
+	policy := snowflake.RowAccessPolicy(id.PolicyName, id.PolicyDatabaseName, id.PolicySchemaName).QualifiedName()
+	return snowflake.RowAccessPolicyAttachment(objectType, id.DatabaseName, id.SchemaName, id.ObjectName).WithPolicy(policy)
+}
+
+func createRowAccessPolicyAttachment(d *schema.ResourceData, meta interface{}, objectType string, objectKey string) error {
+	db := meta.(*sql.DB)
+	policyDB, policySchema, policyName := snowflakeValidation.ParseFullyQualifiedObjectID(d.Get("row_access_policy_name").(string))
+	id := &rowAccessPolicyAttachmentID{
+		DatabaseName:       d.Get("database_name").(string),
+		SchemaName:         d.Get("schema_name").(string),
+		ObjectName:         d.Get(objectKey).(string),
+		PolicyDatabaseName: policyDB,
+		PolicySchemaName:   policySchema,
+		PolicyName:         policyName,
+	}
+
+	builder := id.builder(objectType).WithColumns(expandStringList(d.Get("argument").([]interface{})))
+	if err := snowflake.Exec(db, builder.Add()); err != nil {
+		return fmt.Errorf("error adding row access policy %v to %v %v err = %w", policyName, strings.ToLower(objectType), id.ObjectName, err)
+	}
+
+	idString, err := id.String()
+	if err != nil {
+		return err
+	}
+	d.SetId(idString)
+
+	return readRowAccessPolicyAttachment(d, meta, objectType, objectKey)
+}
+
+func readRowAccessPolicyAttachment(d *schema.ResourceData, meta interface{}, objectType string, objectKey string) error {
+	db := meta.(*sql.DB)
+	id, err := rowAccessPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	references, err := snowflake.ListPolicyReferences(id.builder(objectType).Show(), db)
+	if err != nil {
+		if isObjectNotExistError(err) {
+			log.Printf("[DEBUG] %v of row access policy attachment (%s) not found", strings.ToLower(objectType), d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	var attached *snowflake.PolicyReference
+	for i, reference := range references {
+		if reference.PolicyKind.String == rowAccessPolicyKind {
+			attached = &references[i]
+			break
+		}
+	}
+	// a table or view has at most one row access policy; if it is not ours, the attachment is gone
+	if attached == nil || attached.PolicyDB.String != id.PolicyDatabaseName || attached.PolicySchema.String != id.PolicySchemaName || attached.PolicyName.String != id.PolicyName {
+		log.Printf("[DEBUG] row access policy attachment (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("database_name", id.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema_name", id.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set(objectKey, id.ObjectName); err != nil {
+		return err
+	}
+	// keep the format the policy name was configured in
+	policyDB, policySchema, policyName := snowflakeValidation.ParseFullyQualifiedObjectID(d.Get("row_access_policy_name").(string))
+	if policyDB != id.PolicyDatabaseName || policySchema != id.PolicySchemaName || policyName != id.PolicyName {
+		if err := d.Set("row_access_policy_name", fmt.Sprintf("%v|%v|%v", id.PolicyDatabaseName, id.PolicySchemaName, id.PolicyName)); err != nil {
+			return err
+		}
+	}
+	return d.Set("argument", attached.ArgColumnNames())
+}
+
+func deleteRowAccessPolicyAttachment(d *schema.ResourceData, meta interface{}, objectType string) error {
+	db := meta.(*sql.DB)
+	id, err := rowAccessPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := snowflake.Exec(db, id.builder(objectType).Drop()); err != nil {
+		return fmt.Errorf("error dropping row access policy %v from %v %v err = %w", id.PolicyName, strings.ToLower(objectType), id.ObjectName, err)
+	}
+
+	d.SetId("")
+	return nil
+}
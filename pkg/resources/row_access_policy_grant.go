@@ -10,10 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validRowAccessPoilcyPrivileges = NewPrivilegeSet(
-	privilegeOwnership,
-	privilegeApply,
-)
+var validRowAccessPoilcyPrivileges = privilegesFor("ROW ACCESS POLICY")
 
 var rowAccessPolicyGrantSchema = map[string]*schema.Schema{
 	"database_name": {
@@ -62,6 +59,10 @@ var rowAccessPolicyGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 }
 
 // RowAccessPolicyGrant returns a pointer to the resource representing a row access policy grant.
@@ -172,13 +173,13 @@ func UpdateRowAccessPolicyGrant(d *schema.ResourceData, meta interface{}) error
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, rolesToRevoke, []string{},
+		meta, d.Get("as_role").(string), builder, grantID.Privilege, rolesToRevoke, []string{},
 	); err != nil {
 		return err
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
+		meta, d.Get("as_role").(string), d.Get("current_grants").(string), builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
 	); err != nil {
 		return err
 	}
@@ -7,34 +7,12 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validSchemaPrivileges = NewPrivilegeSet(
-	privilegeAddSearchOptimization,
-	privilegeCreateExternalTable,
-	privilegeCreateFileFormat,
-	privilegeCreateFunction,
-	privilegeCreateMaskingPolicy,
-	privilegeCreateMaterializedView,
-	privilegeCreatePipe,
-	privilegeCreateProcedure,
-	privilegeCreateRowAccessPolicy,
-	privilegeCreateSequence,
-	privilegeCreateSessionPolicy,
-	privilegeCreateStage,
-	privilegeCreateStream,
-	privilegeCreateTable,
-	privilegeCreateTag,
-	privilegeCreateTask,
-	privilegeCreateTemporaryTable,
-	privilegeCreateView,
-	privilegeModify,
-	privilegeMonitor,
-	privilegeOwnership,
-	privilegeUsage,
-)
+var validSchemaPrivileges = privilegesFor("SCHEMA")
 
 var schemaGrantSchema = map[string]*schema.Schema{
 	"schema_name": {
@@ -65,9 +43,9 @@ var schemaGrantSchema = map[string]*schema.Schema{
 	},
 	"shares": {
 		Type:        schema.TypeSet,
-		Elem:        &schema.Schema{Type: schema.TypeString},
+		Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: snowflakeValidation.ValidateShareName},
 		Optional:    true,
-		Description: "Grants privilege to these shares (only valid if on_future is unset).",
+		Description: "Grants privilege to these shares (only valid if on_future and on_all are unset).",
 	},
 	"on_future": {
 		Type:          schema.TypeBool,
@@ -77,6 +55,15 @@ var schemaGrantSchema = map[string]*schema.Schema{
 		ForceNew:      true,
 		ConflictsWith: []string{"schema_name", "shares"},
 	},
+	"on_all": {
+		Type:          schema.TypeBool,
+		Optional:      true,
+		Description:   "When this is set to true, apply this grant on all existing schemas in the given database, e.g. to grant MONITOR on every schema to an observability role. Snowflake does not record such a grant as a whole, so a role is read back as holding it only while it holds the privilege on every existing schema; schemas created since are granted on the next apply. The schema_name and shares fields must be unset in order to use on_all.",
+		Default:       false,
+		ForceNew:      true,
+		ConflictsWith: []string{"schema_name", "shares", "on_future"},
+	},
+	"revoke_existing_on_delete": revokeExistingOnDeleteSchema,
 	"with_grant_option": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -90,6 +77,10 @@ var schemaGrantSchema = map[string]*schema.Schema{
 		Description: "When this is set to true, multiple grants of the same type can be created. This will cause Terraform to not revoke grants applied to roles and objects outside Terraform.",
 		Default:     false,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 }
 
 // SchemaGrant returns a pointer to the resource representing a view grant.
@@ -101,15 +92,37 @@ func SchemaGrant() *TerraformGrantResource {
 			Delete: DeleteSchemaGrant,
 			Update: UpdateSchemaGrant,
 
-			Schema: schemaGrantSchema,
+			Schema:        schemaGrantSchema,
+			CustomizeDiff: validateBulkGrantSupport("SCHEMA"),
 			Importer: &schema.ResourceImporter{
-				StateContext: schema.ImportStatePassthroughContext,
+				StateContext: importFutureGrantWildcard(futureSchemaGrantIn, func(g *futureGrantImport) string {
+					return NewSchemaGrantID(g.DatabaseName, "", g.Privilege, g.Roles, []string{}, g.WithGrantOption).String()
+				}),
 			},
 		},
 		ValidPrivs: validSchemaPrivileges,
 	}
 }
 
+// futureSchemaGrantIn adapts FutureSchemaGrant for importFutureGrantWildcard; future schemas can
+// only be granted in a database.
+func futureSchemaGrantIn(db, _ string) snowflake.GrantBuilder {
+	return snowflake.FutureSchemaGrant(db)
+}
+
+// schemaGrantBuilder returns the builder for a grant on one schema, on future schemas or on all
+// existing schemas of the database.
+func schemaGrantBuilder(databaseName, schemaName string, onFuture, onAll bool) snowflake.GrantBuilder {
+	switch {
+	case onAll:
+		return snowflake.AllSchemaGrant(databaseName)
+	case onFuture:
+		return snowflake.FutureSchemaGrant(databaseName)
+	default:
+		return snowflake.SchemaGrant(databaseName, schemaName)
+	}
+}
+
 // CreateSchemaGrant implements schema.CreateFunc.
 func CreateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	var schemaName string
@@ -119,26 +132,22 @@ func CreateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	databaseName := d.Get("database_name").(string)
 	privilege := d.Get("privilege").(string)
 	onFuture := d.Get("on_future").(bool)
+	onAll := d.Get("on_all").(bool)
 	withGrantOption := d.Get("with_grant_option").(bool)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 	shares := expandStringList(d.Get("shares").(*schema.Set).List())
 
-	if (schemaName == "") && !onFuture {
-		return errors.New("schema_name must be set unless on_future is true")
-	}
-
-	var builder snowflake.GrantBuilder
-	if onFuture {
-		builder = snowflake.FutureSchemaGrant(databaseName)
-	} else {
-		builder = snowflake.SchemaGrant(databaseName, schemaName)
+	if (schemaName == "") && !onFuture && !onAll {
+		return errors.New("schema_name must be set unless on_future or on_all is true")
 	}
 
+	builder := schemaGrantBuilder(databaseName, schemaName, onFuture, onAll)
 	if err := createGenericGrant(d, meta, builder); err != nil {
 		return err
 	}
 
 	grantID := NewSchemaGrantID(databaseName, schemaName, privilege, roles, shares, withGrantOption)
+	grantID.OnAll = onAll
 	d.SetId(grantID.String())
 
 	return ReadSchemaGrant(d, meta)
@@ -171,16 +180,12 @@ func UpdateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	onFuture := d.Get("on_future").(bool)
 
 	// create the builder
-	var builder snowflake.GrantBuilder
-	if onFuture {
-		builder = snowflake.FutureSchemaGrant(grantID.DatabaseName)
-	} else {
-		builder = snowflake.SchemaGrant(grantID.DatabaseName, grantID.SchemaName)
-	}
+	builder := schemaGrantBuilder(grantID.DatabaseName, grantID.SchemaName, onFuture, grantID.OnAll)
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
 		meta,
+		d.Get("as_role").(string),
 		builder,
 		grantID.Privilege,
 		rolesToRevoke,
@@ -192,6 +197,8 @@ func UpdateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	// then add
 	if err := createGenericGrantRolesAndShares(
 		meta,
+		d.Get("as_role").(string),
+		d.Get("current_grants").(string),
 		builder,
 		grantID.Privilege,
 		grantID.WithGrantOption,
@@ -228,12 +235,15 @@ func ReadSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 	onFuture := false
-	if grantID.SchemaName == "" {
+	if grantID.SchemaName == "" && !grantID.OnAll {
 		onFuture = true
 	}
 	if err := d.Set("on_future", onFuture); err != nil {
 		return err
 	}
+	if err := d.Set("on_all", grantID.OnAll); err != nil {
+		return err
+	}
 	if err := d.Set("privilege", grantID.Privilege); err != nil {
 		return err
 	}
@@ -241,12 +251,13 @@ func ReadSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	var builder snowflake.GrantBuilder
-	if onFuture {
-		builder = snowflake.FutureSchemaGrant(grantID.DatabaseName)
-	} else {
-		builder = snowflake.SchemaGrant(grantID.DatabaseName, grantID.SchemaName)
+	// a grant on all schemas is expanded into grants on each schema when it is executed, so it is
+	// read back from each of them
+	if grantID.OnAll {
+		return readAllGrant(d, meta, schemaGrantBuilder(grantID.DatabaseName, "", false, true), validSchemaPrivileges)
 	}
+
+	builder := schemaGrantBuilder(grantID.DatabaseName, grantID.SchemaName, onFuture, false)
 	return readGenericGrant(d, meta, schemaGrantSchema, builder, onFuture, validSchemaPrivileges)
 }
 
@@ -258,16 +269,11 @@ func DeleteSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	onFuture := false
-	if grantID.SchemaName == "" {
+	if grantID.SchemaName == "" && !grantID.OnAll {
 		onFuture = true
 	}
 
-	var builder snowflake.GrantBuilder
-	if onFuture {
-		builder = snowflake.FutureSchemaGrant(grantID.DatabaseName)
-	} else {
-		builder = snowflake.SchemaGrant(grantID.DatabaseName, grantID.SchemaName)
-	}
+	builder := schemaGrantBuilder(grantID.DatabaseName, grantID.SchemaName, onFuture, grantID.OnAll)
 	return deleteGenericGrant(d, meta, builder)
 }
 
@@ -278,6 +284,7 @@ type SchemaGrantID struct {
 	Roles           []string
 	Shares          []string
 	WithGrantOption bool
+	OnAll           bool
 	IsOldID         bool
 }
 
@@ -296,7 +303,12 @@ func NewSchemaGrantID(databaseName string, schemaName, privilege string, roles [
 func (v *SchemaGrantID) String() string {
 	roles := strings.Join(v.Roles, ",")
 	shares := strings.Join(v.Shares, ",")
-	return fmt.Sprintf("%v❄️%v❄️%v❄️%v❄️%v❄️%v", v.DatabaseName, v.SchemaName, v.Privilege, v.WithGrantOption, roles, shares)
+	id := fmt.Sprintf("%v❄️%v❄️%v❄️%v❄️%v❄️%v", v.DatabaseName, v.SchemaName, v.Privilege, v.WithGrantOption, roles, shares)
+	if v.OnAll {
+		// grants on all schemas carry a trailing marker so that they are not read as future grants
+		id += "❄️on_all"
+	}
+	return id
 }
 
 func parseSchemaGrantID(s string) (*SchemaGrantID, error) {
@@ -314,7 +326,7 @@ func parseSchemaGrantID(s string) (*SchemaGrantID, error) {
 		}, nil
 	}
 	idParts := strings.Split(s, "❄️")
-	if len(idParts) != 6 {
+	if len(idParts) != 6 && !(len(idParts) == 7 && idParts[6] == "on_all") {
 		return nil, fmt.Errorf("unexpected number of ID parts (%d), expected 6", len(idParts))
 	}
 	return &SchemaGrantID{
@@ -324,6 +336,7 @@ func parseSchemaGrantID(s string) (*SchemaGrantID, error) {
 		WithGrantOption: idParts[3] == "true",
 		Roles:           helpers.SplitStringToSlice(idParts[4], ","),
 		Shares:          helpers.SplitStringToSlice(idParts[5], ","),
+		OnAll:           len(idParts) == 7,
 		IsOldID:         false,
 	}, nil
 }
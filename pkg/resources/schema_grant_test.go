@@ -137,3 +137,62 @@ func expectReadFutureSchemaGrant(mock sqlmock.Sqlmock) {
 	)
 	mock.ExpectQuery(`^SHOW FUTURE GRANTS IN DATABASE "test-db"$`).WillReturnRows(rows)
 }
+
+func TestAllSchemaGrantCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"on_all":        true,
+		"database_name": "test-db",
+		"privilege":     "MONITOR",
+		"roles":         []interface{}{"observability"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.SchemaGrant().Resource.Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^GRANT MONITOR ON ALL SCHEMAS IN DATABASE "test-db" TO ROLE "observability"$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		// the information schema is not covered by grants on all schemas
+		mock.ExpectQuery(`^SHOW SCHEMAS IN DATABASE "test-db"$`).WillReturnRows(
+			sqlmock.NewRows([]string{"created_on", "name", "database_name"}).
+				AddRow(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "INFORMATION_SCHEMA", "test-db").
+				AddRow(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "PUBLIC", "test-db"),
+		)
+		mock.ExpectQuery(`^SHOW GRANTS TO ROLE "observability"$`).WillReturnRows(
+			sqlmock.NewRows([]string{
+				"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+			}).AddRow(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "MONITOR", "SCHEMA", `"test-db".PUBLIC`, "ROLE", "observability", false, "ACCOUNTADMIN"),
+		)
+		err := resources.CreateSchemaGrant(d, db)
+		r.NoError(err)
+	})
+	r.Equal("test-db❄️❄️MONITOR❄️false❄️observability❄️❄️on_all", d.Id())
+	r.Equal([]interface{}{"observability"}, d.Get("roles").(*schema.Set).List())
+	r.True(d.Get("on_all").(bool))
+	r.False(d.Get("on_future").(bool))
+}
+
+func TestAllSchemaGrantDelete(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"on_all":        true,
+		"database_name": "test-db",
+		"privilege":     "MONITOR",
+		"roles":         []interface{}{"observability"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.SchemaGrant().Resource.Schema, in)
+	d.SetId("test-db❄️❄️MONITOR❄️false❄️observability❄️❄️on_all")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(
+			`^REVOKE MONITOR ON ALL SCHEMAS IN DATABASE "test-db" FROM ROLE "observability"$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		err := resources.DeleteSchemaGrant(d, db)
+		r.NoError(err)
+	})
+}
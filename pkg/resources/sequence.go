@@ -12,6 +12,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -37,6 +38,14 @@ var sequenceSchema = map[string]*schema.Schema{
 		Default:     1,
 		Description: "The amount the sequence will increase by each time it is used",
 	},
+	"ordering": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ValidateFunc:     validation.StringInSlice([]string{"ORDER", "NOORDER"}, true),
+		DiffSuppressFunc: diffCaseInsensitive,
+		Description:      "Whether the sequence generates values in increasing order (ORDER) or may generate them out of order for better performance (NOORDER). When unset the account default applies and is read back.",
+	},
 	"database": {
 		Type:        schema.TypeString,
 		Required:    true,
@@ -113,6 +122,10 @@ func CreateSequence(d *schema.ResourceData, meta interface{}) error {
 		sq.WithComment(v.(string))
 	}
 
+	if v, ok := d.GetOk("ordering"); ok {
+		sq.WithOrdering(v.(string))
+	}
+
 	if err := snowflake.Exec(db, sq.Create()); err != nil {
 		return fmt.Errorf("error creating sequence err = %w", err)
 	}
@@ -175,6 +188,17 @@ func ReadSequence(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	// the ordered column (Y/N) is not reported by older Snowflake releases
+	if sequence.Ordered.Valid {
+		ordering := "NOORDER"
+		if sequence.Ordered.String == "Y" {
+			ordering = "ORDER"
+		}
+		if err := d.Set("ordering", ordering); err != nil {
+			return err
+		}
+	}
+
 	i, err := strconv.ParseInt(sequence.Increment.String, 10, 64)
 	if err != nil {
 		return err
@@ -232,6 +256,10 @@ func UpdateSequence(d *schema.ResourceData, meta interface{}) error {
 		sq.WithComment(v.(string))
 	}
 
+	if v, ok := d.GetOk("ordering"); ok {
+		sq.WithOrdering(v.(string))
+	}
+
 	nextValue, err := strconv.Atoi(sequence.NextValue.String)
 	if err != nil {
 		return err
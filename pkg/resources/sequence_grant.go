@@ -11,10 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validSequencePrivileges = NewPrivilegeSet(
-	privilegeOwnership,
-	privilegeUsage,
-)
+var validSequencePrivileges = privilegesFor("SEQUENCE")
 
 var sequenceGrantSchema = map[string]*schema.Schema{
 	"database_name": {
@@ -30,6 +27,10 @@ var sequenceGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 	"on_future": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -37,6 +38,7 @@ var sequenceGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"revoke_existing_on_delete": revokeExistingOnDeleteSchema,
 	"privilege": {
 		Type:         schema.TypeString,
 		Optional:     true,
@@ -81,9 +83,12 @@ func SequenceGrant() *TerraformGrantResource {
 			Delete: DeleteSequenceGrant,
 			Update: UpdateSequenceGrant,
 
-			Schema: sequenceGrantSchema,
+			Schema:        sequenceGrantSchema,
+			CustomizeDiff: validateBulkGrantSupport("SEQUENCE"),
 			Importer: &schema.ResourceImporter{
-				StateContext: schema.ImportStatePassthroughContext,
+				StateContext: importFutureGrantWildcard(snowflake.FutureSequenceGrant, func(g *futureGrantImport) string {
+					return NewSequenceGrantID(g.DatabaseName, g.SchemaName, "", g.Privilege, g.Roles, g.WithGrantOption).String()
+				}),
 			},
 		},
 		ValidPrivs: validSequencePrivileges,
@@ -225,13 +230,13 @@ func UpdateSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, rolesToRevoke, []string{},
+		meta, d.Get("as_role").(string), builder, grantID.Privilege, rolesToRevoke, []string{},
 	); err != nil {
 		return err
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
+		meta, d.Get("as_role").(string), d.Get("current_grants").(string), builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
 	); err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
 	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 )
 
@@ -31,7 +33,7 @@ func TestSequenceCreate(t *testing.T) {
 	r.NotNil(d)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
-		mock.ExpectExec(`CREATE SEQUENCE "database"."schema"."good_name" COMMENT = 'great comment`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^CREATE SEQUENCE "database"."schema"."good_name" COMMENT = 'great comment'$`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		rows := sqlmock.NewRows([]string{
 			"name",
@@ -102,6 +104,64 @@ func TestSequenceRead(t *testing.T) {
 	})
 }
 
+func TestSequenceOrdering(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":     "good_name",
+		"schema":   "schema",
+		"database": "database",
+		"ordering": "ORDER",
+	}
+	d := schema.TestResourceDataRaw(t, resources.Sequence().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE SEQUENCE "database"."schema"."good_name" ORDER$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{
+			"name", "database_name", "schema_name", "next_value", "interval", "created_on", "owner", "comment", "ordered",
+		}).AddRow("good_name", "database", "schema", "1", "1", "created_on", "owner", "", "Y")
+		mock.ExpectQuery(`SHOW SEQUENCES LIKE 'good_name' IN SCHEMA "database"."schema"`).WillReturnRows(rows)
+		err := resources.CreateSequence(d, db)
+		r.NoError(err)
+	})
+	r.Equal("ORDER", d.Get("ordering").(string))
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"name", "database_name", "schema_name", "next_value", "interval", "created_on", "owner", "comment", "ordered",
+		}).AddRow("good_name", "database", "schema", "1", "1", "created_on", "owner", "", "N")
+		mock.ExpectQuery(`SHOW SEQUENCES LIKE 'good_name' IN SCHEMA "database"."schema"`).WillReturnRows(rows)
+		err := resources.ReadSequence(d, db)
+		r.NoError(err)
+	})
+	r.Equal("NOORDER", d.Get("ordering").(string))
+
+	_, errs := resources.Sequence().Schema["ordering"].ValidateFunc("SOMETIMES", "ordering")
+	r.NotEmpty(errs)
+}
+
+func TestSequenceOrderingUnsetDoesNotDrift(t *testing.T) {
+	r := require.New(t)
+
+	// the ordering read back is kept when the configuration leaves it unset, rather than planning
+	// a change that would drop and recreate the sequence
+	in := map[string]interface{}{
+		"name":     "good_name",
+		"schema":   "schema",
+		"database": "database",
+	}
+	state := schema.TestResourceDataRaw(t, resources.Sequence().Schema, in)
+	state.SetId("database|schema|good_name")
+	r.NoError(state.Set("ordering", "ORDER"))
+
+	diff, err := resources.Sequence().Diff(context.Background(), state.State(), terraform.NewResourceConfigRaw(in), nil)
+	r.NoError(err)
+	if diff != nil {
+		_, ok := diff.Attributes["ordering"]
+		r.False(ok)
+	}
+}
+
 func TestSequenceDelete(t *testing.T) {
 	r := require.New(t)
 	in := map[string]interface{}{
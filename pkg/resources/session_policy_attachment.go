@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var sessionPolicyAttachment = &policyAttachment{
+	policyKind:  "SESSION",
+	policyKey:   "session_policy",
+	objectTypes: []string{"USER", "ROLE"},
+}
+
+var sessionPolicyAttachmentSchema = sessionPolicyAttachment.schema()
+
+// SessionPolicyAttachment returns a pointer to the resource representing a session policy attached
+// to a user or a role.
+func SessionPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateSessionPolicyAttachment,
+		Read:   ReadSessionPolicyAttachment,
+		Delete: DeleteSessionPolicyAttachment,
+
+		Schema: sessionPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateSessionPolicyAttachment implements schema.CreateFunc.
+func CreateSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	return sessionPolicyAttachment.create(d, meta)
+}
+
+// ReadSessionPolicyAttachment implements schema.ReadFunc.
+func ReadSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	return sessionPolicyAttachment.read(d, meta)
+}
+
+// DeleteSessionPolicyAttachment implements schema.DeleteFunc.
+func DeleteSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	return sessionPolicyAttachment.delete(d, meta)
+}
@@ -0,0 +1,97 @@
+package resources_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+const userSessionPolicyReferences = `SELECT * FROM TABLE("sp_db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"user"', REF_ENTITY_DOMAIN => 'USER'))`
+
+func TestSessionPolicyAttachment(t *testing.T) {
+	r := require.New(t)
+	err := resources.SessionPolicyAttachment().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestSessionPolicyAttachmentCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"session_policy": "sp_db|sp_schema|sp_name",
+		"object_type":    "USER",
+		"object_name":    "user",
+	}
+	d := schema.TestResourceDataRaw(t, resources.SessionPolicyAttachment().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER USER "user" SET SESSION POLICY "sp_db"."sp_schema"."sp_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadSessionPolicyAttachment(mock)
+
+		err := resources.CreateSessionPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Equal("USER|user|sp_db|sp_schema|sp_name", d.Id())
+	})
+}
+
+func TestSessionPolicyAttachmentRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.SessionPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("USER|user|sp_db|sp_schema|sp_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadSessionPolicyAttachment(mock)
+
+		err := resources.ReadSessionPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Equal("USER", d.Get("object_type").(string))
+		r.Equal("user", d.Get("object_name").(string))
+		r.Equal("sp_db|sp_schema|sp_name", d.Get("session_policy").(string))
+	})
+}
+
+func TestSessionPolicyAttachmentReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.SessionPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("USER|user|sp_db|sp_schema|sp_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND"})
+		mock.ExpectQuery(regexp.QuoteMeta(userSessionPolicyReferences)).WillReturnRows(rows)
+
+		err := resources.ReadSessionPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func TestSessionPolicyAttachmentDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.SessionPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("USER|user|sp_db|sp_schema|sp_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER USER "user" UNSET SESSION POLICY$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := resources.DeleteSessionPolicyAttachment(d, db)
+		r.NoError(err)
+	})
+}
+
+func expectReadSessionPolicyAttachment(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{
+		"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND", "REF_ENTITY_NAME", "REF_ENTITY_DOMAIN",
+	}).AddRow("sp_db", "sp_schema", "sp_name", "SESSION_POLICY", "user", "USER")
+	mock.ExpectQuery(regexp.QuoteMeta(userSessionPolicyReferences)).WillReturnRows(rows)
+}
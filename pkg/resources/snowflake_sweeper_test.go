@@ -141,13 +141,13 @@ func getIntegrationsSweeper(name string) *resource.Sweeper {
 			if err != nil {
 				return fmt.Errorf("Error getting db handle: %w", err)
 			}
-			integrations, err := snowflake.ListIntegrations(db)
+			integrations, err := snowflake.ListIntegrations("", "", db)
 			if err != nil {
 				return fmt.Errorf("Error listing integrations: %w", err)
 			}
 			for _, integration := range integrations {
 				// can only drop security integrations
-				if integration.IntegrationType.String == "SECURITY" {
+				if integration.Type.String == "SECURITY" {
 					if err := snowflake.DropIntegration(db, integration.Name.String); err != nil {
 						return fmt.Errorf("Error deleting integration %q %w", integration.Name.String, err)
 					}
@@ -11,13 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validStagePrivileges = NewPrivilegeSet(
-	privilegeOwnership,
-	privilegeUsage,
-	// These privileges are only valid for internal stages
-	privilegeRead,
-	privilegeWrite,
-)
+var validStagePrivileges = privilegesFor("STAGE")
 
 var stageGrantSchema = map[string]*schema.Schema{
 	"database_name": {
@@ -33,6 +27,10 @@ var stageGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 	"on_future": {
 		Type:          schema.TypeBool,
 		Optional:      true,
@@ -41,6 +39,7 @@ var stageGrantSchema = map[string]*schema.Schema{
 		ForceNew:      true,
 		ConflictsWith: []string{"stage_name"},
 	},
+	"revoke_existing_on_delete": revokeExistingOnDeleteSchema,
 	"privilege": {
 		Type:         schema.TypeString,
 		Optional:     true,
@@ -86,9 +85,12 @@ func StageGrant() *TerraformGrantResource {
 			Delete: DeleteStageGrant,
 			Update: UpdateStageGrant,
 
-			Schema: stageGrantSchema,
+			Schema:        stageGrantSchema,
+			CustomizeDiff: validateBulkGrantSupport("STAGE"),
 			Importer: &schema.ResourceImporter{
-				StateContext: schema.ImportStatePassthroughContext,
+				StateContext: importFutureGrantWildcard(snowflake.FutureStageGrant, func(g *futureGrantImport) string {
+					return NewStageGrantID(g.DatabaseName, g.SchemaName, "", g.Privilege, g.Roles, g.WithGrantOption).String()
+				}),
 			},
 		},
 		ValidPrivs: validStagePrivileges,
@@ -210,13 +212,13 @@ func UpdateStageGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, rolesToRevoke, []string{},
+		meta, d.Get("as_role").(string), builder, grantID.Privilege, rolesToRevoke, []string{},
 	); err != nil {
 		return err
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
+		meta, d.Get("as_role").(string), d.Get("current_grants").(string), builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
 	); err != nil {
 		return err
 	}
@@ -77,6 +77,8 @@ func TestStorageIntegrationRead(t *testing.T) {
 		err := resources.ReadStorageIntegration(d, db)
 		r.NoError(err)
 	})
+	r.Equal("arn:aws:iam::000000000000:/user/test", d.Get("storage_aws_iam_user_arn"))
+	r.Equal("AGreatExternalID", d.Get("storage_aws_external_id"))
 }
 
 func TestStorageIntegrationReadEmpty(t *testing.T) {
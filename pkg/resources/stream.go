@@ -82,6 +82,26 @@ var streamSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Description: "Name of the role that owns the stream.",
 	},
+	"type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Type of the stream, e.g. DELTA.",
+	},
+	"mode": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Mode of the stream: DEFAULT, APPEND_ONLY or INSERT_ONLY.",
+	},
+	"stale": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the stream is stale, i.e. its offset is outside of the data retention period of the source object and it can no longer be consumed.",
+	},
+	"stale_after": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Timestamp at which the stream is predicted to become stale unless it is consumed.",
+	},
 }
 
 func Stream() *schema.Resource {
@@ -306,8 +326,12 @@ func ReadStream(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	if err := d.Set("show_initial_rows", stream.ShowInitialRows); err != nil {
-		return err
+	// SHOW STREAMS does not report show_initial_rows, so the configured value is kept unless it
+	// does; resetting it would replace the stream on every plan.
+	if stream.ShowInitialRows.Valid {
+		if err := d.Set("show_initial_rows", stream.ShowInitialRows.Bool); err != nil {
+			return err
+		}
 	}
 
 	if err := d.Set("comment", stream.Comment.String); err != nil {
@@ -318,6 +342,22 @@ func ReadStream(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := d.Set("type", stream.Type.String); err != nil {
+		return err
+	}
+
+	if err := d.Set("mode", stream.Mode.String); err != nil {
+		return err
+	}
+
+	if err := d.Set("stale", strings.EqualFold(stream.Stale.String, "true")); err != nil {
+		return err
+	}
+
+	if err := d.Set("stale_after", stream.StaleAfter.String); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -5,22 +5,19 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validStreamPrivileges = NewPrivilegeSet(
-	privilegeOwnership,
-	privilegeSelect,
-)
+var validStreamPrivileges = privilegesFor("STREAM").withAllPrivileges()
 
 var streamGrantSchema = map[string]*schema.Schema{
 	"database_name": {
 		Type:        schema.TypeString,
 		Required:    true,
-		Description: "The name of the database containing the current or future streams on which to grant privileges.",
+		Description: "The name of the database containing the current, future or all streams on which to grant privileges.",
 		ForceNew:    true,
 	},
 	"enable_multiple_grants": {
@@ -30,6 +27,10 @@ var streamGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 	"on_future": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -37,13 +38,21 @@ var streamGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"on_all": {
+		Type:          schema.TypeBool,
+		Optional:      true,
+		Description:   "When this is set to true and a schema_name is provided, apply this grant on all existing streams in the given schema. When this is true and no schema_name is provided apply this grant on all existing streams in the given database. Snowflake does not record such a grant as a whole, so a role is read back as holding it only while it holds the privilege on every existing stream; streams created since are granted on the next apply. The stream_name field must be unset in order to use on_all.",
+		Default:       false,
+		ForceNew:      true,
+		ConflictsWith: []string{"stream_name", "on_future"},
+	},
+	"revoke_existing_on_delete": revokeExistingOnDeleteSchema,
 	"privilege": {
 		Type:         schema.TypeString,
 		Optional:     true,
-		Description:  "The privilege to grant on the current or future stream.",
+		Description:  "The privilege to grant on the current or future stream. ALL PRIVILEGES grants every privilege but OWNERSHIP; it is read back when the roles hold all of them. Changing the privilege revokes the old one and grants the new one in place.",
 		Default:      "SELECT",
 		ValidateFunc: validation.StringInSlice(validStreamPrivileges.ToList(), true),
-		ForceNew:     true,
 	},
 	"roles": {
 		Type:        schema.TypeSet,
@@ -54,13 +63,20 @@ var streamGrantSchema = map[string]*schema.Schema{
 	"schema_name": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "The name of the schema containing the current or future streams on which to grant privileges.",
+		Description: "The name of the schema containing the current, future or all streams on which to grant privileges.",
 		ForceNew:    true,
 	},
+	"shares": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString, ValidateFunc: snowflakeValidation.ValidateShareName},
+		Optional:      true,
+		Description:   "Grants privilege to these shares (only valid if on_future and on_all are unset).",
+		ConflictsWith: []string{"on_future", "on_all"},
+	},
 	"stream_name": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "The name of the stream on which to grant privileges immediately (only valid if on_future is false).",
+		Description: "The name of the stream on which to grant privileges immediately (only valid if on_future and on_all are false).",
 		ForceNew:    true,
 	},
 	"with_grant_option": {
@@ -72,6 +88,24 @@ var streamGrantSchema = map[string]*schema.Schema{
 	},
 }
 
+var streamGrantCRUD = grantResourceCRUD(streamGrantSchema, streamGrantBuilder, validStreamPrivileges, grantIDCodec{
+	fromConfig: streamGrantIDFromConfig,
+	parse: func(s string) (grantID, error) {
+		return parseStreamGrantID(s)
+	},
+})
+
+var (
+	// CreateStreamGrant implements schema.CreateFunc.
+	CreateStreamGrant = streamGrantCRUD.Create
+	// ReadStreamGrant implements schema.ReadFunc.
+	ReadStreamGrant = streamGrantCRUD.Read
+	// UpdateStreamGrant implements schema.UpdateFunc.
+	UpdateStreamGrant = streamGrantCRUD.Update
+	// DeleteStreamGrant implements schema.DeleteFunc.
+	DeleteStreamGrant = streamGrantCRUD.Delete
+)
+
 // StreamGrant returns a pointer to the resource representing a stream grant.
 func StreamGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
@@ -81,17 +115,30 @@ func StreamGrant() *TerraformGrantResource {
 			Delete: DeleteStreamGrant,
 			Update: UpdateStreamGrant,
 
-			Schema: streamGrantSchema,
+			Schema:        streamGrantSchema,
+			CustomizeDiff: validateBulkGrantSupport("STREAM"),
 			Importer: &schema.ResourceImporter{
-				StateContext: schema.ImportStatePassthroughContext,
+				StateContext: importFutureGrantWildcard(snowflake.FutureStreamGrant, func(g *futureGrantImport) string {
+					return NewStreamGrantID(g.DatabaseName, g.SchemaName, "", g.Privilege, g.Roles, []string{}, g.WithGrantOption).String()
+				}),
 			},
 		},
 		ValidPrivs: validStreamPrivileges,
 	}
 }
 
-// CreateStreamGrant implements schema.CreateFunc.
-func CreateStreamGrant(d *schema.ResourceData, meta interface{}) error {
+func streamGrantBuilder(id grantID) snowflake.GrantBuilder {
+	streamID := id.(*StreamGrantID)
+	if streamID.OnAll {
+		return snowflake.AllStreamGrant(streamID.DatabaseName, streamID.SchemaName)
+	}
+	if streamID.onFuture() {
+		return snowflake.FutureStreamGrant(streamID.DatabaseName, streamID.SchemaName)
+	}
+	return snowflake.StreamGrant(streamID.DatabaseName, streamID.SchemaName, streamID.ObjectName)
+}
+
+func streamGrantIDFromConfig(d *schema.ResourceData) (grantID, error) {
 	var streamName string
 	if name, ok := d.GetOk("stream_name"); ok {
 		streamName = name.(string)
@@ -100,168 +147,108 @@ func CreateStreamGrant(d *schema.ResourceData, meta interface{}) error {
 	schemaName := d.Get("schema_name").(string)
 	privilege := d.Get("privilege").(string)
 	onFuture := d.Get("on_future").(bool)
+	onAll := d.Get("on_all").(bool)
 	withGrantOption := d.Get("with_grant_option").(bool)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
+	shares := expandStringList(d.Get("shares").(*schema.Set).List())
 
-	if (streamName == "") && !onFuture {
-		return errors.New("stream_name must be set unless on_future is true")
+	if (streamName == "") && !onFuture && !onAll {
+		return nil, errors.New("stream_name must be set unless on_future or on_all is true")
 	}
-	if (streamName != "") && onFuture {
-		return errors.New("stream_name must be empty if on_future is true")
+	if (streamName != "") && (onFuture || onAll) {
+		return nil, errors.New("stream_name must be empty if on_future or on_all is true")
 	}
-	if (schemaName == "") && !onFuture {
-		return errors.New("schema_name must be set unless on_future is true")
+	if (schemaName == "") && !onFuture && !onAll {
+		return nil, errors.New("schema_name must be set unless on_future or on_all is true")
 	}
-
-	var builder snowflake.GrantBuilder
-	if onFuture {
-		builder = snowflake.FutureStreamGrant(databaseName, schemaName)
-	} else {
-		builder = snowflake.StreamGrant(databaseName, schemaName, streamName)
+	if len(shares) > 0 && (onFuture || onAll) {
+		return nil, errors.New("shares must be empty if on_future or on_all is true")
 	}
+	grantID := NewStreamGrantID(databaseName, schemaName, streamName, privilege, roles, shares, withGrantOption)
+	grantID.OnAll = onAll
+	grantID.EnableMultipleGrants = d.Get("enable_multiple_grants").(bool)
+	return grantID, nil
+}
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
-	}
-
-	grantID := NewStreamGrantID(databaseName, schemaName, streamName, privilege, roles, withGrantOption)
-	d.SetId(grantID.String())
-
-	return ReadStreamGrant(d, meta)
+type StreamGrantID struct {
+	DatabaseName    string
+	SchemaName      string
+	ObjectName      string
+	Privilege       string
+	Roles           []string
+	Shares          []string
+	WithGrantOption bool
+	OnAll           bool
+	// EnableMultipleGrants is encoded so that importing a grant keeps it; hasEnableMultipleGrants
+	// is false for IDs written before it was, which leave it as configured.
+	EnableMultipleGrants    bool
+	hasEnableMultipleGrants bool
 }
 
-// ReadStreamGrant implements schema.ReadFunc.
-func ReadStreamGrant(d *schema.ResourceData, meta interface{}) error {
-	grantID, err := parseStreamGrantID(d.Id())
-	if err != nil {
-		return err
+func NewStreamGrantID(databaseName string, schemaName, objectName, privilege string, roles []string, shares []string, withGrantOption bool) *StreamGrantID {
+	return &StreamGrantID{
+		DatabaseName:            databaseName,
+		SchemaName:              schemaName,
+		ObjectName:              objectName,
+		Privilege:               privilege,
+		Roles:                   roles,
+		Shares:                  shares,
+		WithGrantOption:         withGrantOption,
+		hasEnableMultipleGrants: true,
 	}
+}
 
-	if err := d.Set("database_name", grantID.DatabaseName); err != nil {
-		return err
-	}
+func (v *StreamGrantID) privilege() string {
+	return v.Privilege
+}
 
-	if err := d.Set("schema_name", grantID.SchemaName); err != nil {
-		return err
-	}
-	onFuture := false
-	if grantID.ObjectName == "" {
-		onFuture = true
-	}
+func (v *StreamGrantID) withGrantOption() bool {
+	return v.WithGrantOption
+}
 
-	if err := d.Set("stream_name", grantID.ObjectName); err != nil {
-		return err
-	}
+func (v *StreamGrantID) onFuture() bool {
+	return v.ObjectName == "" && !v.OnAll
+}
 
-	if err := d.Set("on_future", onFuture); err != nil {
-		return err
-	}
+func (v *StreamGrantID) onAll() bool {
+	return v.OnAll
+}
 
-	if err := d.Set("privilege", grantID.Privilege); err != nil {
+func (v *StreamGrantID) setObject(d *schema.ResourceData) error {
+	if err := d.Set("database_name", v.DatabaseName); err != nil {
 		return err
 	}
-
-	if err := d.Set("with_grant_option", grantID.WithGrantOption); err != nil {
+	if err := d.Set("schema_name", v.SchemaName); err != nil {
 		return err
 	}
-
-	var builder snowflake.GrantBuilder
-	if onFuture {
-		builder = snowflake.FutureStreamGrant(grantID.DatabaseName, grantID.SchemaName)
-	} else {
-		builder = snowflake.StreamGrant(grantID.DatabaseName, grantID.SchemaName, grantID.ObjectName)
-	}
-
-	return readGenericGrant(d, meta, streamGrantSchema, builder, onFuture, validStreamPrivileges)
-}
-
-// DeleteStreamGrant implements schema.DeleteFunc.
-func DeleteStreamGrant(d *schema.ResourceData, meta interface{}) error {
-	grantID, err := parseStreamGrantID(d.Id())
-	if err != nil {
+	if err := d.Set("stream_name", v.ObjectName); err != nil {
 		return err
 	}
-
-	onFuture := (grantID.ObjectName == "")
-
-	var builder snowflake.GrantBuilder
-	if onFuture {
-		builder = snowflake.FutureStreamGrant(grantID.DatabaseName, grantID.SchemaName)
-	} else {
-		builder = snowflake.StreamGrant(grantID.DatabaseName, grantID.SchemaName, grantID.ObjectName)
-	}
-	return deleteGenericGrant(d, meta, builder)
-}
-
-// UpdateStreamGrant implements schema.UpdateFunc.
-func UpdateStreamGrant(d *schema.ResourceData, meta interface{}) error {
-	// for now the only thing we can update are roles or shares
-	// if nothing changed, nothing to update and we're done
-	if !d.HasChanges("roles") {
-		return nil
-	}
-
-	rolesToAdd := []string{}
-	rolesToRevoke := []string{}
-
-	if d.HasChange("roles") {
-		rolesToAdd, rolesToRevoke = changeDiff(d, "roles")
-	}
-
-	grantID, err := parseStreamGrantID(d.Id())
-	if err != nil {
+	if err := d.Set("on_future", v.onFuture()); err != nil {
 		return err
 	}
-
-	onFuture := (grantID.ObjectName == "")
-
-	var builder snowflake.GrantBuilder
-	if onFuture {
-		builder = snowflake.FutureStreamGrant(grantID.DatabaseName, grantID.SchemaName)
-	} else {
-		builder = snowflake.StreamGrant(grantID.DatabaseName, grantID.SchemaName, grantID.ObjectName)
+	if v.hasEnableMultipleGrants {
+		if err := d.Set("enable_multiple_grants", v.EnableMultipleGrants); err != nil {
+			return err
+		}
 	}
-
-	// first revoke
-	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, rolesToRevoke, []string{},
-	); err != nil {
+	if err := seedGrantees(d, v.Roles, v.Shares); err != nil {
 		return err
 	}
-	// then add
-	if err := createGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
-	); err != nil {
-		return err
-	}
-
-	// Done, refresh state
-	return ReadStreamGrant(d, meta)
-}
-
-type StreamGrantID struct {
-	DatabaseName    string
-	SchemaName      string
-	ObjectName      string
-	Privilege       string
-	Roles           []string
-	WithGrantOption bool
-}
-
-func NewStreamGrantID(databaseName string, schemaName, objectName, privilege string, roles []string, withGrantOption bool) *StreamGrantID {
-	return &StreamGrantID{
-		DatabaseName:    databaseName,
-		SchemaName:      schemaName,
-		ObjectName:      objectName,
-		Privilege:       privilege,
-		Roles:           roles,
-		WithGrantOption: withGrantOption,
-	}
+	return d.Set("on_all", v.OnAll)
 }
 
 func (v *StreamGrantID) String() string {
-	roles := strings.Join(v.Roles, ",")
-	return fmt.Sprintf("%v❄️%v❄️%v❄️%v❄️%v❄️%v", v.DatabaseName, v.SchemaName, v.ObjectName, v.Privilege, v.WithGrantOption, roles)
+	// names may contain the separator, so they are escaped
+	roles := escapeGrantIDList(v.Roles)
+	shares := escapeGrantIDList(v.Shares)
+	id := fmt.Sprintf("%v❄️%v❄️%v❄️%v❄️%v❄️%v❄️%v❄️%v",
+		escapeGrantIDPart(v.DatabaseName), escapeGrantIDPart(v.SchemaName), escapeGrantIDPart(v.ObjectName), v.Privilege, v.WithGrantOption, roles, shares, v.EnableMultipleGrants)
+	if v.OnAll {
+		// grants on all streams carry a trailing marker so that they are not read as future grants
+		id += "❄️on_all"
+	}
+	return id
 }
 
 func parseStreamGrantID(s string) (*StreamGrantID, error) {
@@ -274,19 +261,37 @@ func parseStreamGrantID(s string) (*StreamGrantID, error) {
 			ObjectName:      idParts[2],
 			Privilege:       idParts[3],
 			Roles:           []string{},
+			Shares:          []string{},
 			WithGrantOption: idParts[4] == "true",
 		}, nil
 	}
 	idParts := strings.Split(s, "❄️")
-	if len(idParts) != 6 {
-		return nil, fmt.Errorf("unexpected number of ID parts (%d), expected 6", len(idParts))
+	onAll := len(idParts) > 6 && idParts[len(idParts)-1] == "on_all"
+	if onAll {
+		idParts = idParts[:len(idParts)-1]
+	}
+	// IDs written before shares were supported have no shares part, and IDs written before
+	// enable_multiple_grants was encoded have no flag
+	if len(idParts) == 6 {
+		idParts = append(idParts, "")
+	}
+	hasEnableMultipleGrants := len(idParts) == 8
+	if len(idParts) == 7 {
+		idParts = append(idParts, "")
+	}
+	if len(idParts) != 8 {
+		return nil, fmt.Errorf("unexpected number of ID parts (%d), expected 8", len(idParts))
 	}
 	return &StreamGrantID{
-		DatabaseName:    idParts[0],
-		SchemaName:      idParts[1],
-		ObjectName:      idParts[2],
-		Privilege:       idParts[3],
-		WithGrantOption: idParts[4] == "true",
-		Roles:           helpers.SplitStringToSlice(idParts[5], ","),
+		DatabaseName:            unescapeGrantIDPart(idParts[0]),
+		SchemaName:              unescapeGrantIDPart(idParts[1]),
+		ObjectName:              unescapeGrantIDPart(idParts[2]),
+		Privilege:               idParts[3],
+		WithGrantOption:         idParts[4] == "true",
+		Roles:                   unescapeGrantIDList(idParts[5]),
+		Shares:                  unescapeGrantIDList(idParts[6]),
+		EnableMultipleGrants:    idParts[7] == "true",
+		hasEnableMultipleGrants: hasEnableMultipleGrants,
+		OnAll:                   onAll,
 	}, nil
 }
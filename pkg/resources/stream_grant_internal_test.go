@@ -0,0 +1,26 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamGrantIDEscapesSeparator(t *testing.T) {
+	r := require.New(t)
+
+	id := NewStreamGrantID("db❄️1", "❄️", "stream❄️❄️name", "SELECT", []string{"role❄️a", "role_b"}, []string{"ACCT.share❄️"}, true)
+	s := id.String()
+	r.Equal("db__SNOWFLAKE__1❄️__SNOWFLAKE__❄️stream__SNOWFLAKE____SNOWFLAKE__name❄️SELECT❄️true❄️role__SNOWFLAKE__a,role_b❄️ACCT.share__SNOWFLAKE__❄️false", s)
+
+	parsed, err := parseStreamGrantID(s)
+	r.NoError(err)
+	r.Equal("db❄️1", parsed.DatabaseName)
+	r.Equal("❄️", parsed.SchemaName)
+	r.Equal("stream❄️❄️name", parsed.ObjectName)
+	r.Equal("SELECT", parsed.Privilege)
+	r.True(parsed.WithGrantOption)
+	r.Equal([]string{"role❄️a", "role_b"}, parsed.Roles)
+	r.Equal([]string{"ACCT.share❄️"}, parsed.Shares)
+	r.Equal(s, parsed.String())
+}
@@ -2,6 +2,7 @@ package resources_test
 
 import (
 	"database/sql"
+	"fmt"
 	"testing"
 	"time"
 
@@ -10,6 +11,8 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
 	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/snowflakedb/gosnowflake"
 	"github.com/stretchr/testify/require"
 )
 
@@ -68,6 +71,386 @@ func TestStreamGrantRead(t *testing.T) {
 	r.Equal(2, roles.Len())
 }
 
+func TestStreamGrantReadUpgradesLegacyID(t *testing.T) {
+	r := require.New(t)
+
+	d := streamGrant(t, "test-db|PUBLIC|test-stream|SELECT|false", map[string]interface{}{
+		"stream_name":            "test-stream",
+		"schema_name":            "PUBLIC",
+		"database_name":          "test-db",
+		"privilege":              "SELECT",
+		"roles":                  []interface{}{"test-role-1"},
+		"enable_multiple_grants": true,
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadStreamGrant(mock)
+		err := resources.ReadStreamGrant(d, db)
+		r.NoError(err)
+	})
+	// the grantees and enable_multiple_grants come from the state, which the legacy ID lacks
+	r.Equal("test-db❄️PUBLIC❄️test-stream❄️SELECT❄️false❄️test-role-1❄️❄️true", d.Id())
+	r.True(d.Get("enable_multiple_grants").(bool))
+}
+
+func TestStreamGrantReadKeepsCurrentID(t *testing.T) {
+	r := require.New(t)
+
+	id := "test-db❄️PUBLIC❄️test-stream❄️SELECT❄️false❄️test-role-1❄️"
+	d := streamGrant(t, id, map[string]interface{}{
+		"stream_name":   "test-stream",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadStreamGrant(mock)
+		err := resources.ReadStreamGrant(d, db)
+		r.NoError(err)
+	})
+	r.Equal(id, d.Id())
+}
+
+func TestStreamGrantImportEnableMultipleGrants(t *testing.T) {
+	r := require.New(t)
+
+	d := streamGrant(t, "test-db❄️PUBLIC❄️test-stream❄️SELECT❄️false❄️test-role-1❄️❄️true", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadStreamGrant(mock)
+		err := resources.ReadStreamGrant(d, db)
+		r.NoError(err)
+	})
+	r.True(d.Get("enable_multiple_grants").(bool))
+	// the roles of the ID are kept, the grants of other roles are not claimed by the imported resource
+	roles := d.Get("roles").(*schema.Set)
+	r.True(roles.Contains("test-role-1"))
+	r.Equal(1, roles.Len())
+}
+
+func TestStreamGrantReadLegacyIDKeepsEnableMultipleGrants(t *testing.T) {
+	r := require.New(t)
+
+	d := streamGrant(t, "test-db❄️PUBLIC❄️test-stream❄️SELECT❄️false❄️test-role-1❄️", map[string]interface{}{
+		"stream_name":            "test-stream",
+		"schema_name":            "PUBLIC",
+		"database_name":          "test-db",
+		"privilege":              "SELECT",
+		"roles":                  []interface{}{"test-role-1"},
+		"enable_multiple_grants": true,
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadStreamGrant(mock)
+		err := resources.ReadStreamGrant(d, db)
+		r.NoError(err)
+	})
+	r.True(d.Get("enable_multiple_grants").(bool))
+	roles := d.Get("roles").(*schema.Set)
+	r.True(roles.Contains("test-role-1"))
+	r.Equal(1, roles.Len())
+}
+
+func TestStreamGrantCreateAllPrivileges(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"stream_name":   "test-stream",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "ALL PRIVILEGES",
+		"roles":         []interface{}{"test-role-1", "test-role-2"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.StreamGrant().Resource.Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^GRANT ALL PRIVILEGES ON STREAM "test-db"."PUBLIC"."test-stream" TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^GRANT ALL PRIVILEGES ON STREAM "test-db"."PUBLIC"."test-stream" TO ROLE "test-role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		// SHOW GRANTS reports the individual privileges granted by ALL PRIVILEGES
+		expectReadStreamGrant(mock)
+		err := resources.CreateStreamGrant(d, db)
+		r.NoError(err)
+	})
+	r.Contains(d.Id(), "test-db❄️PUBLIC❄️test-stream❄️ALL PRIVILEGES❄️false❄️")
+	r.Equal("ALL PRIVILEGES", d.Get("privilege").(string))
+	roles := d.Get("roles").(*schema.Set)
+	r.True(roles.Contains("test-role-1"))
+	r.True(roles.Contains("test-role-2"))
+	r.Equal(2, roles.Len())
+	r.Equal("bob", d.Get("granted_by.test-role-1").(string))
+}
+
+func TestStreamGrantReadAllPrivilegesMissing(t *testing.T) {
+	r := require.New(t)
+
+	d := streamGrant(t, "test-db❄️PUBLIC❄️test-stream❄️ALL PRIVILEGES❄️false❄️test-role-1", map[string]interface{}{
+		"stream_name":   "test-stream",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "ALL PRIVILEGES",
+		"roles":         []interface{}{"test-role-1"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// only OWNERSHIP, which ALL PRIVILEGES does not grant
+		rows := sqlmock.NewRows([]string{
+			"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+		}).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "OWNERSHIP", "STREAM", "test-stream", "ROLE", "test-role-1", false, "bob",
+		)
+		mock.ExpectQuery(`^SHOW GRANTS ON STREAM "test-db"."PUBLIC"."test-stream"$`).WillReturnRows(rows)
+		err := resources.ReadStreamGrant(d, db)
+		r.NoError(err)
+	})
+	r.Equal("ALL PRIVILEGES", d.Get("privilege").(string))
+	r.Equal(0, d.Get("roles").(*schema.Set).Len())
+}
+
+func TestStreamGrantDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := streamGrant(t, "test-db❄️PUBLIC❄️test-stream❄️SELECT❄️false❄️test-role-1", map[string]interface{}{
+		"stream_name":   "test-stream",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`^REVOKE SELECT ON STREAM "test-db"."PUBLIC"."test-stream" FROM ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		err := resources.DeleteStreamGrant(d, db)
+		r.NoError(err)
+		r.Equal("", d.Id())
+	})
+}
+
+func TestStreamGrantCreateShares(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"stream_name":   "test-stream",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+		"shares":        []interface{}{"test-share-1"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.StreamGrant().Resource.Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^GRANT SELECT ON STREAM "test-db"."PUBLIC"."test-stream" TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^GRANT SELECT ON STREAM "test-db"."PUBLIC"."test-stream" TO SHARE "test-share-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadStreamGrantShares(mock)
+		err := resources.CreateStreamGrant(d, db)
+		r.NoError(err)
+	})
+	r.Equal("test-db❄️PUBLIC❄️test-stream❄️SELECT❄️false❄️test-role-1❄️test-share-1❄️false", d.Id())
+	shares := d.Get("shares").(*schema.Set)
+	r.True(shares.Contains("test-share-1"))
+	r.Equal(1, shares.Len())
+}
+
+func TestStreamGrantUpdateShares(t *testing.T) {
+	r := require.New(t)
+
+	params := map[string]interface{}{
+		"stream_name":   "test-stream",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+	}
+	newParams := map[string]interface{}{
+		"stream_name":   "test-stream",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+		"shares":        []interface{}{"test-share-1"},
+	}
+	d := streamGrantUpdate(t, "test-db❄️PUBLIC❄️test-stream❄️SELECT❄️false❄️test-role-1❄️", params, newParams)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^GRANT SELECT ON STREAM "test-db"."PUBLIC"."test-stream" TO SHARE "test-share-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadStreamGrantShares(mock)
+		err := resources.UpdateStreamGrant(d, db)
+		r.NoError(err)
+	})
+	r.True(d.Get("shares").(*schema.Set).Contains("test-share-1"))
+}
+
+func TestStreamGrantUpdatePrivilege(t *testing.T) {
+	r := require.New(t)
+
+	params := map[string]interface{}{
+		"stream_name":   "test-stream",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+	}
+	newParams := map[string]interface{}{
+		"stream_name":   "test-stream",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "ALL PRIVILEGES",
+		"roles":         []interface{}{"test-role-1", "test-role-2"},
+	}
+	d := streamGrantUpdate(t, "test-db❄️PUBLIC❄️test-stream❄️SELECT❄️false❄️test-role-1❄️", params, newParams)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// the old privilege is only revoked from the roles it was granted to
+		mock.ExpectBegin()
+		mock.ExpectExec(`^REVOKE SELECT ON STREAM "test-db"."PUBLIC"."test-stream" FROM ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectExec(`^GRANT ALL PRIVILEGES ON STREAM "test-db"."PUBLIC"."test-stream" TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^GRANT ALL PRIVILEGES ON STREAM "test-db"."PUBLIC"."test-stream" TO ROLE "test-role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadStreamGrant(mock)
+		err := resources.UpdateStreamGrant(d, db)
+		r.NoError(err)
+	})
+	r.Contains(d.Id(), "test-db❄️PUBLIC❄️test-stream❄️ALL PRIVILEGES❄️false❄️")
+	r.Equal("ALL PRIVILEGES", d.Get("privilege").(string))
+	r.Equal(2, d.Get("roles").(*schema.Set).Len())
+}
+
+func TestStreamGrantDeleteShares(t *testing.T) {
+	r := require.New(t)
+
+	d := streamGrant(t, "test-db❄️PUBLIC❄️test-stream❄️SELECT❄️false❄️test-role-1❄️test-share-1", map[string]interface{}{
+		"stream_name":   "test-stream",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+		"shares":        []interface{}{"test-share-1"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`^REVOKE SELECT ON STREAM "test-db"."PUBLIC"."test-stream" FROM ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectExec(`^REVOKE SELECT ON STREAM "test-db"."PUBLIC"."test-stream" FROM SHARE "test-share-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		err := resources.DeleteStreamGrant(d, db)
+		r.NoError(err)
+		r.Equal("", d.Id())
+	})
+}
+
+func TestFutureStreamGrantCreateRejectsShares(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.StreamGrant().Resource.Schema, map[string]interface{}{
+		"on_future":     true,
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+		"shares":        []interface{}{"test-share-1"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		err := resources.CreateStreamGrant(d, db)
+		r.EqualError(err, "shares must be empty if on_future or on_all is true")
+	})
+}
+
+func TestFutureStreamGrantSharesConflict(t *testing.T) {
+	r := require.New(t)
+
+	diags := resources.StreamGrant().Resource.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"on_future":     true,
+		"database_name": "test-db",
+		"roles":         []interface{}{"test-role-1"},
+		"shares":        []interface{}{"test-share-1"},
+	}))
+	r.True(diags.HasError())
+}
+
+func TestFutureStreamGrantUpdateRejectsShares(t *testing.T) {
+	r := require.New(t)
+
+	params := map[string]interface{}{
+		"on_future":     true,
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+	}
+	newParams := map[string]interface{}{
+		"on_future":     true,
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+		"shares":        []interface{}{"test-share-1"},
+	}
+	d := streamGrantUpdate(t, "test-db❄️❄️❄️SELECT❄️false❄️test-role-1❄️❄️false", params, newParams)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		err := resources.UpdateStreamGrant(d, db)
+		r.EqualError(err, "privileges on future or all objects cannot be granted to share test-share-1")
+	})
+}
+
+func expectReadStreamGrantShares(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{
+		"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+	}).AddRow(
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "SELECT", "STREAM", "test-stream", "ROLE", "test-role-1", false, "bob",
+	).AddRow(
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "SELECT", "STREAM", "test-stream", "SHARE", "ACCT.test-share-1", false, "bob",
+	)
+	mock.ExpectQuery(`^SHOW GRANTS ON STREAM "test-db"."PUBLIC"."test-stream"$`).WillReturnRows(rows)
+}
+
+func TestStreamGrantReadShares(t *testing.T) {
+	r := require.New(t)
+
+	d := streamGrant(t, "test-db❄️PUBLIC❄️test-stream❄️SELECT❄️false❄️test-role-1❄️test-share-1,test-share-2❄️true", map[string]interface{}{
+		"stream_name":            "test-stream",
+		"schema_name":            "PUBLIC",
+		"database_name":          "test-db",
+		"privilege":              "SELECT",
+		"roles":                  []interface{}{"test-role-1"},
+		"shares":                 []interface{}{"test-share-1", "test-share-2"},
+		"enable_multiple_grants": true,
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// test-share-2 was revoked outside Terraform
+		expectReadStreamGrantShares(mock)
+		err := resources.ReadStreamGrant(d, db)
+		r.NoError(err)
+	})
+	shares := d.Get("shares").(*schema.Set)
+	r.True(shares.Contains("test-share-1"))
+	r.Equal(1, shares.Len())
+}
+
+func TestStreamGrantCreateRequiresStreamName(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.StreamGrant().Resource.Schema, map[string]interface{}{
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		err := resources.CreateStreamGrant(d, db)
+		r.EqualError(err, "stream_name must be set unless on_future or on_all is true")
+		r.Equal("", d.Id())
+	})
+}
+
 func expectReadStreamGrant(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{
 		"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
@@ -130,6 +513,125 @@ func TestFutureStreamGrantCreate(t *testing.T) {
 	})
 }
 
+func TestAllStreamGrantCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"on_all":        true,
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.StreamGrant().Resource.Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^GRANT SELECT ON ALL STREAMS IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-1"$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadAllStreamGrant(mock, "test-role-1", "STREAM_1", "STREAM_2")
+		err := resources.CreateStreamGrant(d, db)
+		r.NoError(err)
+	})
+	r.Equal("test-db❄️PUBLIC❄️❄️SELECT❄️false❄️test-role-1❄️❄️false❄️on_all", d.Id())
+	r.Equal([]interface{}{"test-role-1"}, d.Get("roles").(*schema.Set).List())
+	r.True(d.Get("on_all").(bool))
+	r.False(d.Get("on_future").(bool))
+}
+
+func TestAllStreamGrantRead(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"on_all":        true,
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1", "test-role-2"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.StreamGrant().Resource.Schema, in)
+	d.SetId("test-db❄️PUBLIC❄️❄️SELECT❄️false❄️test-role-1,test-role-2❄️❄️false❄️on_all")
+
+	// test-role-2 lacks SELECT on a stream created after the grant, so it is granted again on the
+	// next apply
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadAllStreamGrant(mock, "test-role-1", "STREAM_1", "STREAM_2")
+		mock.ExpectQuery(`^SHOW GRANTS TO ROLE "test-role-2"$`).WillReturnRows(allStreamGrantRows("test-role-2", "STREAM_1"))
+		err := resources.ReadStreamGrant(d, db)
+		r.NoError(err)
+	})
+	r.Equal([]interface{}{"test-role-1"}, d.Get("roles").(*schema.Set).List())
+}
+
+func TestAllStreamGrantReadSchemaDropped(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"on_all":        true,
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.StreamGrant().Resource.Schema, in)
+	d.SetId("test-db❄️PUBLIC❄️❄️SELECT❄️false❄️test-role-1❄️❄️false❄️on_all")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SHOW STREAMS IN SCHEMA "test-db"."PUBLIC"$`).WillReturnError(&gosnowflake.SnowflakeError{
+			Number:  2003,
+			Message: "Schema 'test-db.PUBLIC' does not exist or not authorized.",
+		})
+		err := resources.ReadStreamGrant(d, db)
+		r.NoError(err)
+	})
+	r.Empty(d.Id())
+}
+
+// expectReadAllStreamGrant expects the streams in test-db.PUBLIC to be listed and role to hold
+// SELECT on each of them.
+func expectReadAllStreamGrant(mock sqlmock.Sqlmock, role string, streams ...string) {
+	rows := sqlmock.NewRows([]string{"created_on", "name", "database_name", "schema_name"})
+	for _, stream := range streams {
+		rows.AddRow(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), stream, "test-db", "PUBLIC")
+	}
+	mock.ExpectQuery(`^SHOW STREAMS IN SCHEMA "test-db"."PUBLIC"$`).WillReturnRows(rows)
+	mock.ExpectQuery(fmt.Sprintf(`^SHOW GRANTS TO ROLE "%v"$`, role)).WillReturnRows(allStreamGrantRows(role, streams...))
+}
+
+func allStreamGrantRows(role string, streams ...string) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{
+		"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+	})
+	for _, stream := range streams {
+		rows.AddRow(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "SELECT", "STREAM", `"test-db".PUBLIC.`+stream, "ROLE", role, false, "ACCOUNTADMIN")
+	}
+	return rows
+}
+
+func TestAllStreamGrantDelete(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"on_all":        true,
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.StreamGrant().Resource.Schema, in)
+	d.SetId("test-db❄️❄️❄️SELECT❄️false❄️test-role-1❄️on_all")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(
+			`^REVOKE SELECT ON ALL STREAMS IN DATABASE "test-db" FROM ROLE "test-role-1"$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		err := resources.DeleteStreamGrant(d, db)
+		r.NoError(err)
+	})
+}
+
 func expectReadFutureStreamGrant(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{
 		"created_on", "privilege", "grant_on", "name", "grant_to", "grantee_name", "grant_option",
@@ -151,3 +653,66 @@ func expectReadFutureStreamDatabaseGrant(mock sqlmock.Sqlmock) {
 	)
 	mock.ExpectQuery(`^SHOW FUTURE GRANTS IN DATABASE "test-db"$`).WillReturnRows(rows)
 }
+
+func TestStreamGrantReadRoleNameCase(t *testing.T) {
+	r := require.New(t)
+
+	d := streamGrant(t, "test-db❄️PUBLIC❄️test-stream❄️SELECT❄️false❄️test-role-1", map[string]interface{}{
+		"stream_name":       "test-stream",
+		"schema_name":       "PUBLIC",
+		"database_name":     "test-db",
+		"privilege":         "SELECT",
+		"roles":             []interface{}{"myrole"},
+		"with_grant_option": false,
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+		}).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "SELECT", "STREAM", "test-stream", "ROLE", "MYROLE", false, "bob",
+		)
+		mock.ExpectQuery(`^SHOW GRANTS ON STREAM "test-db"."PUBLIC"."test-stream"$`).WillReturnRows(rows)
+		err := resources.ReadStreamGrant(d, db)
+		r.NoError(err)
+	})
+
+	roles := d.Get("roles").(*schema.Set)
+	r.Equal(1, roles.Len())
+	r.True(roles.Contains("myrole"))
+}
+
+func TestStreamGrantReadRoleNameCaseKeepsDistinctRoles(t *testing.T) {
+	r := require.New(t)
+
+	d := streamGrant(t, "test-db❄️PUBLIC❄️test-stream❄️SELECT❄️false❄️analyst,my-role", map[string]interface{}{
+		"stream_name":       "test-stream",
+		"schema_name":       "PUBLIC",
+		"database_name":     "test-db",
+		"privilege":         "SELECT",
+		"roles":             []interface{}{"analyst", "my-role"},
+		"with_grant_option": false,
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+		}).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "SELECT", "STREAM", "test-stream", "ROLE", "analyst", false, "bob",
+		).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "SELECT", "STREAM", "test-stream", "ROLE", "ANALYST", false, "bob",
+		).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "SELECT", "STREAM", "test-stream", "ROLE", "MY-ROLE", false, "bob",
+		)
+		mock.ExpectQuery(`^SHOW GRANTS ON STREAM "test-db"."PUBLIC"."test-stream"$`).WillReturnRows(rows)
+		err := resources.ReadStreamGrant(d, db)
+		r.NoError(err)
+	})
+
+	// ANALYST is a different role than analyst, which was read as well, and my-role is quoted so
+	// it cannot be read back as MY-ROLE
+	roles := d.Get("roles").(*schema.Set)
+	r.ElementsMatch([]interface{}{"analyst", "ANALYST", "MY-ROLE"}, roles.List())
+}
@@ -40,6 +40,7 @@ func TestStreamCreate(t *testing.T) {
 		err := resources.CreateStream(d, db)
 		r.NoError(err)
 		r.Equal("stream_name", d.Get("name").(string))
+		r.True(d.Get("show_initial_rows").(bool))
 	})
 }
 
@@ -170,6 +171,8 @@ func TestStreamReadAppendOnlyMode(t *testing.T) {
 		err := resources.ReadStream(d, db)
 		r.NoError(err)
 		r.Equal(true, d.Get("append_only").(bool))
+		r.Equal("DELTA", d.Get("type").(string))
+		r.Equal("APPEND_ONLY", d.Get("mode").(string))
 	})
 }
 
@@ -184,6 +187,8 @@ func TestStreamReadInsertOnlyMode(t *testing.T) {
 		err := resources.ReadStream(d, db)
 		r.NoError(err)
 		r.Equal(true, d.Get("insert_only").(bool))
+		r.Equal("DELTA", d.Get("type").(string))
+		r.Equal("INSERT_ONLY", d.Get("mode").(string))
 	})
 }
 
@@ -198,9 +203,59 @@ func TestStreamReadDefaultMode(t *testing.T) {
 		err := resources.ReadStream(d, db)
 		r.NoError(err)
 		r.Equal(false, d.Get("append_only").(bool))
+		r.Equal("DELTA", d.Get("type").(string))
+		r.Equal("DEFAULT", d.Get("mode").(string))
 	})
 }
 
+func TestStreamReadStale(t *testing.T) {
+	r := require.New(t)
+
+	d := stream(t, "database_name|schema_name|stream_name", map[string]interface{}{"name": "stream_name"})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"name", "database_name", "schema_name", "owner", "comment", "table_name", "type", "stale", "stale_after", "mode"}).
+			AddRow("stream_name", "database_name", "schema_name", "owner_name", "", "target_table", "DELTA", "true", "2023-01-15 08:30:00.000 -0800", "DEFAULT")
+		mock.ExpectQuery(`SHOW STREAMS LIKE 'stream_name' IN SCHEMA "database_name"."schema_name"`).WillReturnRows(rows)
+		err := resources.ReadStream(d, db)
+		r.NoError(err)
+	})
+	r.True(d.Get("stale").(bool))
+	r.Equal("2023-01-15 08:30:00.000 -0800", d.Get("stale_after").(string))
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectStreamRead(mock)
+		err := resources.ReadStream(d, db)
+		r.NoError(err)
+	})
+	r.False(d.Get("stale").(bool))
+	r.Empty(d.Get("stale_after").(string))
+}
+
+func TestStreamReadShowInitialRows(t *testing.T) {
+	r := require.New(t)
+
+	d := stream(t, "database_name|schema_name|stream_name", map[string]interface{}{"name": "stream_name", "show_initial_rows": true})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"name", "database_name", "schema_name", "owner", "comment", "table_name", "type", "stale", "mode", "show_initial_rows"}).
+			AddRow("stream_name", "database_name", "schema_name", "owner_name", "", "target_table", "DELTA", "false", "DEFAULT", false)
+		mock.ExpectQuery(`SHOW STREAMS LIKE 'stream_name' IN SCHEMA "database_name"."schema_name"`).WillReturnRows(rows)
+		err := resources.ReadStream(d, db)
+		r.NoError(err)
+	})
+	r.False(d.Get("show_initial_rows").(bool))
+
+	// without the column the configured value is kept
+	r.NoError(d.Set("show_initial_rows", true))
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectStreamRead(mock)
+		err := resources.ReadStream(d, db)
+		r.NoError(err)
+	})
+	r.True(d.Get("show_initial_rows").(bool))
+}
+
 func TestStreamDelete(t *testing.T) {
 	r := require.New(t)
 
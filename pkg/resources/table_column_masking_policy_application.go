@@ -0,0 +1,224 @@
+package resources
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
+)
+
+const (
+	columnMaskingPolicyApplicationIDDelimiter = '|'
+	maskingPolicyKind                         = "MASKING_POLICY"
+)
+
+var tableColumnMaskingPolicyApplicationSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database of the table.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema of the table.",
+	},
+	"table": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the table.",
+	},
+	"column": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the column the masking policy is applied to.",
+	},
+	"masking_policy": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "The fully qualified name of the masking policy, either \"databaseName\".\"schemaName\".\"policyName\", databaseName.schemaName.policyName or databaseName|schemaName|policyName (snowflake_masking_policy.policy.id).",
+		ValidateFunc: snowflakeValidation.ValidateFullyQualifiedObjectID,
+	},
+}
+
+// TableColumnMaskingPolicyApplication returns a pointer to the resource representing a masking policy
+// applied to a table column.
+func TableColumnMaskingPolicyApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateTableColumnMaskingPolicyApplication,
+		Read:   ReadTableColumnMaskingPolicyApplication,
+		Delete: DeleteTableColumnMaskingPolicyApplication,
+
+		Schema: tableColumnMaskingPolicyApplicationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+type tableColumnMaskingPolicyApplicationID struct {
+	DatabaseName       string
+	SchemaName         string
+	TableName          string
+	ColumnName         string
+	PolicyDatabaseName string
+	PolicySchemaName   string
+	PolicyName         string
+}
+
+// String() takes in a tableColumnMaskingPolicyApplicationID object and returns a pipe-delimited string:
+// DatabaseName|SchemaName|TableName|ColumnName|PolicyDatabaseName|PolicySchemaName|PolicyName.
+func (id *tableColumnMaskingPolicyApplicationID) String() (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = columnMaskingPolicyApplicationIDDelimiter
+	dataIdentifiers := [][]string{{id.DatabaseName, id.SchemaName, id.TableName, id.ColumnName, id.PolicyDatabaseName, id.PolicySchemaName, id.PolicyName}}
+	if err := csvWriter.WriteAll(dataIdentifiers); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// tableColumnMaskingPolicyApplicationIDFromString() takes in a pipe-delimited string:
+// DatabaseName|SchemaName|TableName|ColumnName|PolicyDatabaseName|PolicySchemaName|PolicyName
+// and returns a tableColumnMaskingPolicyApplicationID object.
+func tableColumnMaskingPolicyApplicationIDFromString(stringID string) (*tableColumnMaskingPolicyApplicationID, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = columnMaskingPolicyApplicationIDDelimiter
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("not CSV compatible")
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("1 line per masking policy application")
+	}
+	if len(lines[0]) != 7 {
+		return nil, fmt.Errorf("7 fields allowed")
+	}
+
+	return &tableColumnMaskingPolicyApplicationID{
+		DatabaseName:       lines[0][0],
+		SchemaName:         lines[0][1],
+		TableName:          lines[0][2],
+		ColumnName:         lines[0][3],
+		PolicyDatabaseName: lines[0][4],
+		PolicySchemaName:   lines[0][5],
+		PolicyName:         lines[0][6],
+	}, nil
+}
+
+func (id *tableColumnMaskingPolicyApplicationID) builder() *snowflake.ColumnMaskingPolicyApplicationBuilder {
+	policy := snowflake.MaskingPolicy(id.PolicyName, id.PolicyDatabaseName, id.PolicySchemaName).QualifiedName()
+	return snowflake.ColumnMaskingPolicyApplication(id.DatabaseName, id.SchemaName, id.TableName, id.ColumnName).WithPolicy(policy)
+}
+
+// CreateTableColumnMaskingPolicyApplication implements schema.CreateFunc.
+func CreateTableColumnMaskingPolicyApplication(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	policyDB, policySchema, policyName := snowflakeValidation.ParseFullyQualifiedObjectID(d.Get("masking_policy").(string))
+	id := &tableColumnMaskingPolicyApplicationID{
+		DatabaseName:       d.Get("database").(string),
+		SchemaName:         d.Get("schema").(string),
+		TableName:          d.Get("table").(string),
+		ColumnName:         d.Get("column").(string),
+		PolicyDatabaseName: policyDB,
+		PolicySchemaName:   policySchema,
+		PolicyName:         policyName,
+	}
+
+	if err := snowflake.Exec(db, id.builder().Set()); err != nil {
+		return fmt.Errorf("error setting masking policy %v on column %v of table %v err = %w", policyName, id.ColumnName, id.TableName, err)
+	}
+
+	idString, err := id.String()
+	if err != nil {
+		return err
+	}
+	d.SetId(idString)
+
+	return ReadTableColumnMaskingPolicyApplication(d, meta)
+}
+
+// ReadTableColumnMaskingPolicyApplication implements schema.ReadFunc.
+func ReadTableColumnMaskingPolicyApplication(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	id, err := tableColumnMaskingPolicyApplicationIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	references, err := snowflake.ListPolicyReferences(id.builder().Show(), db)
+	if err != nil {
+		if isObjectNotExistError(err) {
+			log.Printf("[DEBUG] table of masking policy application (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	found := false
+	for _, reference := range references {
+		if reference.PolicyKind.String == maskingPolicyKind &&
+			reference.RefColumnName.String == id.ColumnName &&
+			reference.PolicyDB.String == id.PolicyDatabaseName &&
+			reference.PolicySchema.String == id.PolicySchemaName &&
+			reference.PolicyName.String == id.PolicyName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		log.Printf("[DEBUG] masking policy application (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("database", id.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", id.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("table", id.TableName); err != nil {
+		return err
+	}
+	if err := d.Set("column", id.ColumnName); err != nil {
+		return err
+	}
+	// keep the format the policy name was configured in
+	policyDB, policySchema, policyName := snowflakeValidation.ParseFullyQualifiedObjectID(d.Get("masking_policy").(string))
+	if policyDB != id.PolicyDatabaseName || policySchema != id.PolicySchemaName || policyName != id.PolicyName {
+		return d.Set("masking_policy", fmt.Sprintf("%v|%v|%v", id.PolicyDatabaseName, id.PolicySchemaName, id.PolicyName))
+	}
+	return nil
+}
+
+// DeleteTableColumnMaskingPolicyApplication implements schema.DeleteFunc.
+func DeleteTableColumnMaskingPolicyApplication(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	id, err := tableColumnMaskingPolicyApplicationIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := snowflake.Exec(db, id.builder().Unset()); err != nil {
+		return fmt.Errorf("error unsetting masking policy %v from column %v of table %v err = %w", id.PolicyName, id.ColumnName, id.TableName, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,102 @@
+package resources_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+const tableColumnMaskingPolicyReferences = `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"db"."schema"."table"', REF_ENTITY_DOMAIN => 'TABLE'))`
+
+func TestTableColumnMaskingPolicyApplication(t *testing.T) {
+	r := require.New(t)
+	err := resources.TableColumnMaskingPolicyApplication().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestTableColumnMaskingPolicyApplicationCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database":       "db",
+		"schema":         "schema",
+		"table":          "table",
+		"column":         "EMAIL",
+		"masking_policy": "mp_db.mp_schema.mp_name",
+	}
+	d := schema.TestResourceDataRaw(t, resources.TableColumnMaskingPolicyApplication().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER TABLE "db"."schema"."table" ALTER COLUMN "EMAIL" SET MASKING POLICY "mp_db"."mp_schema"."mp_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadTableColumnMaskingPolicyApplication(mock)
+
+		err := resources.CreateTableColumnMaskingPolicyApplication(d, db)
+		r.NoError(err)
+		r.Equal("db|schema|table|EMAIL|mp_db|mp_schema|mp_name", d.Id())
+		r.Equal("mp_db.mp_schema.mp_name", d.Get("masking_policy").(string))
+	})
+}
+
+func TestTableColumnMaskingPolicyApplicationRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.TableColumnMaskingPolicyApplication().Schema, map[string]interface{}{})
+	d.SetId("db|schema|table|EMAIL|mp_db|mp_schema|mp_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadTableColumnMaskingPolicyApplication(mock)
+
+		err := resources.ReadTableColumnMaskingPolicyApplication(d, db)
+		r.NoError(err)
+		r.Equal("table", d.Get("table").(string))
+		r.Equal("EMAIL", d.Get("column").(string))
+		r.Equal("mp_db|mp_schema|mp_name", d.Get("masking_policy").(string))
+	})
+}
+
+func TestTableColumnMaskingPolicyApplicationReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.TableColumnMaskingPolicyApplication().Schema, map[string]interface{}{})
+	d.SetId("db|schema|table|EMAIL|mp_db|mp_schema|mp_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// the policy is applied to another column
+		rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND", "REF_COLUMN_NAME"}).
+			AddRow("mp_db", "mp_schema", "mp_name", "MASKING_POLICY", "PHONE")
+		mock.ExpectQuery(regexp.QuoteMeta(tableColumnMaskingPolicyReferences)).WillReturnRows(rows)
+
+		err := resources.ReadTableColumnMaskingPolicyApplication(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func TestTableColumnMaskingPolicyApplicationDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.TableColumnMaskingPolicyApplication().Schema, map[string]interface{}{})
+	d.SetId("db|schema|table|EMAIL|mp_db|mp_schema|mp_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER TABLE "db"."schema"."table" ALTER COLUMN "EMAIL" UNSET MASKING POLICY$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := resources.DeleteTableColumnMaskingPolicyApplication(d, db)
+		r.NoError(err)
+	})
+}
+
+func expectReadTableColumnMaskingPolicyApplication(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{
+		"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND", "REF_DATABASE_NAME", "REF_SCHEMA_NAME", "REF_ENTITY_NAME", "REF_ENTITY_DOMAIN", "REF_COLUMN_NAME",
+	}).AddRow("mp_db", "mp_schema", "mp_name", "MASKING_POLICY", "db", "schema", "table", "TABLE", "EMAIL")
+	mock.ExpectQuery(regexp.QuoteMeta(tableColumnMaskingPolicyReferences)).WillReturnRows(rows)
+}
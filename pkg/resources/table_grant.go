@@ -7,20 +7,12 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validTablePrivileges = NewPrivilegeSet(
-	privilegeSelect,
-	privilegeInsert,
-	privilegeUpdate,
-	privilegeDelete,
-	privilegeTruncate,
-	privilegeReferences,
-	privilegeRebuild,
-	privilegeOwnership,
-)
+var validTablePrivileges = privilegesFor("TABLE")
 
 var tableGrantSchema = map[string]*schema.Schema{
 	"table_name": {
@@ -57,7 +49,7 @@ var tableGrantSchema = map[string]*schema.Schema{
 	},
 	"shares": {
 		Type:        schema.TypeSet,
-		Elem:        &schema.Schema{Type: schema.TypeString},
+		Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: snowflakeValidation.ValidateShareName},
 		Optional:    true,
 		Description: "Grants privilege to these shares (only valid if on_future is unset).",
 	},
@@ -69,6 +61,7 @@ var tableGrantSchema = map[string]*schema.Schema{
 		ForceNew:      true,
 		ConflictsWith: []string{"table_name", "shares"},
 	},
+	"revoke_existing_on_delete": revokeExistingOnDeleteSchema,
 	"with_grant_option": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -82,6 +75,10 @@ var tableGrantSchema = map[string]*schema.Schema{
 		Description: "When this is set to true, multiple grants of the same type can be created. This will cause Terraform to not revoke grants applied to roles and objects outside Terraform.",
 		Default:     false,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 }
 
 // TableGrant returns a pointer to the resource representing a Table grant.
@@ -93,9 +90,12 @@ func TableGrant() *TerraformGrantResource {
 			Delete: DeleteTableGrant,
 			Update: UpdateTableGrant,
 
-			Schema: tableGrantSchema,
+			Schema:        tableGrantSchema,
+			CustomizeDiff: validateBulkGrantSupport("TABLE"),
 			Importer: &schema.ResourceImporter{
-				StateContext: schema.ImportStatePassthroughContext,
+				StateContext: importFutureGrantWildcard(snowflake.FutureTableGrant, func(g *futureGrantImport) string {
+					return NewTableGrantID(g.DatabaseName, g.SchemaName, "", g.Privilege, g.Roles, []string{}, g.WithGrantOption).String()
+				}),
 			},
 		},
 		ValidPrivs: validTablePrivileges,
@@ -259,6 +259,7 @@ func UpdateTableGrant(d *schema.ResourceData, meta interface{}) error {
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
 		meta,
+		d.Get("as_role").(string),
 		builder,
 		grantID.Privilege,
 		rolesToRevoke,
@@ -270,6 +271,8 @@ func UpdateTableGrant(d *schema.ResourceData, meta interface{}) error {
 	// then add
 	if err := createGenericGrantRolesAndShares(
 		meta,
+		d.Get("as_role").(string),
+		d.Get("current_grants").(string),
 		builder,
 		grantID.Privilege,
 		grantID.WithGrantOption,
@@ -0,0 +1,37 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var tableRowAccessPolicyAttachmentSchema = rowAccessPolicyAttachmentSchema("TABLE", "table_name")
+
+// TableRowAccessPolicyAttachment returns a pointer to the resource representing a row access policy
+// attached to a table.
+func TableRowAccessPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateTableRowAccessPolicyAttachment,
+		Read:   ReadTableRowAccessPolicyAttachment,
+		Delete: DeleteTableRowAccessPolicyAttachment,
+
+		Schema: tableRowAccessPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateTableRowAccessPolicyAttachment implements schema.CreateFunc.
+func CreateTableRowAccessPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	return createRowAccessPolicyAttachment(d, meta, "TABLE", "table_name")
+}
+
+// ReadTableRowAccessPolicyAttachment implements schema.ReadFunc.
+func ReadTableRowAccessPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	return readRowAccessPolicyAttachment(d, meta, "TABLE", "table_name")
+}
+
+// DeleteTableRowAccessPolicyAttachment implements schema.DeleteFunc.
+func DeleteTableRowAccessPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	return deleteRowAccessPolicyAttachment(d, meta, "TABLE")
+}
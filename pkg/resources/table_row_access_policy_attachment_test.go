@@ -0,0 +1,103 @@
+package resources_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+const tableRowAccessPolicyReferences = `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"db"."schema"."table"', REF_ENTITY_DOMAIN => 'TABLE'))`
+
+func TestTableRowAccessPolicyAttachment(t *testing.T) {
+	r := require.New(t)
+	err := resources.TableRowAccessPolicyAttachment().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestTableRowAccessPolicyAttachmentCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database_name":          "db",
+		"schema_name":            "schema",
+		"table_name":             "table",
+		"row_access_policy_name": "rap_db|rap_schema|rap_name",
+		"argument":               []interface{}{"REGION", "ID"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.TableRowAccessPolicyAttachment().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER TABLE "db"."schema"."table" ADD ROW ACCESS POLICY "rap_db"."rap_schema"."rap_name" ON \("REGION", "ID"\)$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadTableRowAccessPolicyAttachment(mock)
+
+		err := resources.CreateTableRowAccessPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Equal("db|schema|table|rap_db|rap_schema|rap_name", d.Id())
+		r.Equal("rap_db|rap_schema|rap_name", d.Get("row_access_policy_name").(string))
+		r.Equal([]interface{}{"REGION", "ID"}, d.Get("argument").([]interface{}))
+	})
+}
+
+func TestTableRowAccessPolicyAttachmentRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.TableRowAccessPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("db|schema|table|rap_db|rap_schema|rap_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadTableRowAccessPolicyAttachment(mock)
+
+		err := resources.ReadTableRowAccessPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Equal("table", d.Get("table_name").(string))
+		r.Equal("rap_db|rap_schema|rap_name", d.Get("row_access_policy_name").(string))
+		r.Equal([]interface{}{"REGION", "ID"}, d.Get("argument").([]interface{}))
+	})
+}
+
+func TestTableRowAccessPolicyAttachmentReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.TableRowAccessPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("db|schema|table|rap_db|rap_schema|rap_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// only a masking policy is attached to the table
+		rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND", "REF_COLUMN_NAME"}).
+			AddRow("mp_db", "mp_schema", "mp_name", "MASKING_POLICY", "EMAIL")
+		mock.ExpectQuery(regexp.QuoteMeta(tableRowAccessPolicyReferences)).WillReturnRows(rows)
+
+		err := resources.ReadTableRowAccessPolicyAttachment(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func TestTableRowAccessPolicyAttachmentDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.TableRowAccessPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("db|schema|table|rap_db|rap_schema|rap_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER TABLE "db"."schema"."table" DROP ROW ACCESS POLICY "rap_db"."rap_schema"."rap_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := resources.DeleteTableRowAccessPolicyAttachment(d, db)
+		r.NoError(err)
+	})
+}
+
+func expectReadTableRowAccessPolicyAttachment(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{
+		"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND", "REF_DATABASE_NAME", "REF_SCHEMA_NAME", "REF_ENTITY_NAME", "REF_ENTITY_DOMAIN", "REF_ARG_COLUMN_NAMES",
+	}).AddRow("rap_db", "rap_schema", "rap_name", "ROW_ACCESS_POLICY", "db", "schema", "table", "TABLE", `[ "REGION", "ID" ]`)
+	mock.ExpectQuery(regexp.QuoteMeta(tableRowAccessPolicyReferences)).WillReturnRows(rows)
+}
@@ -161,6 +161,41 @@ func tagIDFromString(stringID string) (*TagID, error) {
 	return tagResult, nil
 }
 
+// importedTagIDFromString() is tagIDFromString() for the ID of a tag being imported, which may also
+// leave out the schema (DatabaseName|tagName) or both the database and the schema (tagName).
+func importedTagIDFromString(stringID string) (*TagID, error) {
+	if !strings.Contains(stringID, string(tagIDDelimiter)) {
+		return &TagID{TagName: stringID}, nil
+	}
+	if parts := strings.Split(stringID, string(tagIDDelimiter)); len(parts) == 2 {
+		return &TagID{DatabaseName: parts[0], TagName: parts[1]}, nil
+	}
+	return tagIDFromString(stringID)
+}
+
+// lookupTag finds the tag of a partially qualified ID, so that its database and schema can be read.
+func lookupTag(tagID *TagID, db *sql.DB) (*snowflake.Tag, error) {
+	tags, err := snowflake.ListTagsLike(tagID.TagName, tagID.DatabaseName, db)
+	if err != nil {
+		return nil, err
+	}
+	// LIKE treats _ and % as wildcards
+	var found []snowflake.Tag
+	for _, t := range tags {
+		if t.Name.String == tagID.TagName {
+			found = append(found, t)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, sql.ErrNoRows
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("tag %v exists in %d schemas, import it as database|schema|name", tagID.TagName, len(found))
+	}
+}
+
 // Schema returns a pointer to the resource representing a schema.
 func Tag() *schema.Resource {
 	return &schema.Resource{
@@ -217,7 +252,7 @@ func CreateTag(d *schema.ResourceData, meta interface{}) error {
 // ReadSchema implements schema.ReadFunc.
 func ReadTag(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
-	tagID, err := tagIDFromString(d.Id())
+	tagID, err := importedTagIDFromString(d.Id())
 	if err != nil {
 		return err
 	}
@@ -226,10 +261,14 @@ func ReadTag(d *schema.ResourceData, meta interface{}) error {
 	schemaName := tagID.SchemaName
 	tag := tagID.TagName
 
-	q := snowflake.NewTagBuilder(tag).WithDB(dbName).WithSchema(schemaName).Show()
-	row := snowflake.QueryRow(db, q)
-
-	t, err := snowflake.ScanTag(row)
+	var t *snowflake.Tag
+	if schemaName == "" {
+		t, err = lookupTag(tagID, db)
+	} else {
+		q := snowflake.NewTagBuilder(tag).WithDB(dbName).WithSchema(schemaName).Show()
+		row := snowflake.QueryRow(db, q)
+		t, err = snowflake.ScanTag(row)
+	}
 	if errors.Is(err, sql.ErrNoRows) {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] tag (%s) not found", d.Id())
@@ -252,6 +291,14 @@ func ReadTag(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if schemaName == "" {
+		qualifiedID, err := (&TagID{DatabaseName: t.DatabaseName.String, SchemaName: t.SchemaName.String, TagName: t.Name.String}).String()
+		if err != nil {
+			return err
+		}
+		d.SetId(qualifiedID)
+	}
+
 	if err := d.Set("comment", t.Comment.String); err != nil {
 		return err
 	}
@@ -10,10 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validTagPrivileges = NewPrivilegeSet(
-	privilegeOwnership,
-	privilegeApply,
-)
+var validTagPrivileges = privilegesFor("TAG")
 
 var tagGrantSchema = map[string]*schema.Schema{
 	"database_name": {
@@ -62,6 +59,10 @@ var tagGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 }
 
 // TagGrant returns a pointer to the resource representing a tag grant.
@@ -162,6 +163,7 @@ func UpdateTagGrant(d *schema.ResourceData, meta interface{}) error {
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
 		meta,
+		d.Get("as_role").(string),
 		builder,
 		grantID.Privilege,
 		rolesToRevoke,
@@ -173,6 +175,8 @@ func UpdateTagGrant(d *schema.ResourceData, meta interface{}) error {
 	// then add
 	if err := createGenericGrantRolesAndShares(
 		meta,
+		d.Get("as_role").(string),
+		d.Get("current_grants").(string),
 		builder,
 		grantID.Privilege,
 		grantID.WithGrantOption,
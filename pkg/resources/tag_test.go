@@ -121,3 +121,44 @@ func expectReadTag(mock sqlmock.Sqlmock) {
 	).AddRow("2019-05-19 16:55:36.530 -0700", "good_name", "test_db", "test_schema", "admin", "great comment", "'al1','al2'")
 	mock.ExpectQuery(`^SHOW TAGS LIKE 'good_name' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
 }
+
+func TestTagImportUnqualified(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.Tag().Schema, map[string]interface{}{})
+	d.SetId("good_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// LIKE also matches goodXname, which must not be mistaken for the imported tag
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "owner", "comment", "allowed_values",
+		}).
+			AddRow("2019-05-19 16:55:36.530 -0700", "goodXname", "other_db", "other_schema", "admin", "", "").
+			AddRow("2019-05-19 16:55:36.530 -0700", "good_name", "test_db", "test_schema", "admin", "great comment", "'al1','al2'")
+		mock.ExpectQuery(`^SHOW TAGS LIKE 'good_name'$`).WillReturnRows(rows)
+		err := resources.ReadTag(d, db)
+		r.NoError(err)
+	})
+	r.Equal("test_db|test_schema|good_name", d.Id())
+	r.Equal("test_db", d.Get("database").(string))
+	r.Equal("test_schema", d.Get("schema").(string))
+	r.Equal("great comment", d.Get("comment").(string))
+}
+
+func TestTagImportInDatabaseAmbiguous(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.Tag().Schema, map[string]interface{}{})
+	d.SetId("test_db|good_name")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "owner", "comment", "allowed_values",
+		}).
+			AddRow("2019-05-19 16:55:36.530 -0700", "good_name", "test_db", "test_schema", "admin", "", "").
+			AddRow("2019-05-19 16:55:36.530 -0700", "good_name", "test_db", "other_schema", "admin", "", "")
+		mock.ExpectQuery(`^SHOW TAGS LIKE 'good_name' IN DATABASE "test_db"$`).WillReturnRows(rows)
+		err := resources.ReadTag(d, db)
+		r.EqualError(err, "tag good_name exists in 2 schemas, import it as database|schema|name")
+	})
+}
@@ -11,11 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validTaskPrivileges = NewPrivilegeSet(
-	privilegeMonitor,
-	privilegeOperate,
-	privilegeOwnership,
-)
+var validTaskPrivileges = privilegesFor("TASK")
 
 var taskGrantSchema = map[string]*schema.Schema{
 	"database_name": {
@@ -31,6 +27,10 @@ var taskGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 	"on_future": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -38,11 +38,12 @@ var taskGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"revoke_existing_on_delete": revokeExistingOnDeleteSchema,
 	"privilege": {
 		Type:         schema.TypeString,
 		Optional:     true,
 		Description:  "The privilege to grant on the current or future task.",
-		Default:      "USAGE",
+		Default:      "MONITOR",
 		ValidateFunc: validation.StringInSlice(validTaskPrivileges.ToList(), true),
 		ForceNew:     true,
 	},
@@ -82,9 +83,12 @@ func TaskGrant() *TerraformGrantResource {
 			Delete: DeleteTaskGrant,
 			Update: UpdateTaskGrant,
 
-			Schema: taskGrantSchema,
+			Schema:        taskGrantSchema,
+			CustomizeDiff: validateBulkGrantSupport("TASK"),
 			Importer: &schema.ResourceImporter{
-				StateContext: schema.ImportStatePassthroughContext,
+				StateContext: importFutureGrantWildcard(snowflake.FutureTaskGrant, func(g *futureGrantImport) string {
+					return NewTaskGrantID(g.DatabaseName, g.SchemaName, "", g.Privilege, g.Roles, g.WithGrantOption).String()
+				}),
 			},
 		},
 		ValidPrivs: validTaskPrivileges,
@@ -231,13 +235,13 @@ func UpdateTaskGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, rolesToRevoke, []string{},
+		meta, d.Get("as_role").(string), builder, grantID.Privilege, rolesToRevoke, []string{},
 	); err != nil {
 		return err
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
+		meta, d.Get("as_role").(string), d.Get("current_grants").(string), builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, []string{},
 	); err != nil {
 		return err
 	}
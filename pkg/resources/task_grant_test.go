@@ -19,6 +19,21 @@ func TestTaskGrant(t *testing.T) {
 	r.NoError(err)
 }
 
+func TestTaskGrantDefaultPrivilege(t *testing.T) {
+	r := require.New(t)
+
+	res := resources.TaskGrant()
+	d := schema.TestResourceDataRaw(t, res.Resource.Schema, map[string]interface{}{
+		"task_name":     "test-task",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"roles":         []interface{}{"test-role-1"},
+	})
+	privilege := d.Get("privilege").(string)
+	r.Equal("MONITOR", privilege)
+	r.Contains(res.ValidPrivs.ToList(), privilege)
+}
+
 func TestTaskGrantCreate(t *testing.T) {
 	r := require.New(t)
 
@@ -0,0 +1 @@
+SELECT t.ACCOUNT_ID, t.SCORE FROM TABLE(ANALYTICS.PUBLIC.SCORE_ACCOUNTS(TO_DATE('2023-01-01'), 'daily')) t WHERE t.SCORE > 0.5
@@ -0,0 +1,8 @@
+select t.account_id, t.score
+from table(
+    analytics.public.score_accounts(
+        to_date('2023-01-01'),
+        'daily'
+    )
+) t
+where t.score > 0.5
@@ -0,0 +1,11 @@
+/*
+ * Daily revenue per account.
+ * Owner: analytics
+ */
+SELECT
+    account_id, -- the paying account
+    SUM(amount) AS revenue, // converted to USD upstream
+    '-- not a comment' AS marker
+FROM payments /* settled only */
+WHERE status = 'settled'
+GROUP BY account_id -- one row per account
@@ -0,0 +1 @@
+SELECT account_id, SUM(amount) AS revenue, '-- not a comment' AS marker FROM payments WHERE status = 'settled' GROUP BY account_id
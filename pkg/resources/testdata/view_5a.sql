@@ -0,0 +1 @@
+SELECT p.ACCOUNT_ID, SUM(p.AMOUNT) AS REVENUE FROM PAYMENTS p WHERE p.STATUS = 'Settled' AND p.REGION = 'EU  West' GROUP BY p.ACCOUNT_ID;
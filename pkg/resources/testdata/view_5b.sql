@@ -0,0 +1,7 @@
+select
+    p.account_id,
+    sum(p.amount) as revenue
+from payments p
+where p.status = 'settled'
+    and p.region = 'EU West'
+group by p.account_id
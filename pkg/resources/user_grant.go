@@ -10,10 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validUserPrivileges = NewPrivilegeSet(
-	privilegeMonitor,
-	privilegeOwnership,
-)
+var validUserPrivileges = privilegesFor("USER")
 
 var userGrantSchema = map[string]*schema.Schema{
 	"user_name": {
@@ -48,6 +45,10 @@ var userGrantSchema = map[string]*schema.Schema{
 		Description: "When this is set to true, multiple grants of the same type can be created. This will cause Terraform to not revoke grants applied to roles and objects outside Terraform.",
 		Default:     false,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 }
 
 // UserGrant returns a pointer to the resource representing a user grant.
@@ -150,6 +151,7 @@ func UpdateUserGrant(d *schema.ResourceData, meta interface{}) error {
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
 		meta,
+		d.Get("as_role").(string),
 		builder,
 		grantID.Privilege,
 		rolesToRevoke,
@@ -161,6 +163,8 @@ func UpdateUserGrant(d *schema.ResourceData, meta interface{}) error {
 	// then add
 	if err := createGenericGrantRolesAndShares(
 		meta,
+		d.Get("as_role").(string),
+		d.Get("current_grants").(string),
 		builder,
 		grantID.Privilege,
 		grantID.WithGrantOption,
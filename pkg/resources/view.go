@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"errors"
@@ -9,12 +10,20 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var space = regexp.MustCompile(`\s+`)
+var (
+	space = regexp.MustCompile(`\s+`)
+	// spaceInParens matches whitespace just inside parentheses, which formatters add and remove
+	// freely around table function arguments and subqueries, e.g. TABLE( my_udtf(1) ).
+	spaceInParens = regexp.MustCompile(`\(\s+|\s+\)`)
+)
 
 var viewSchema = map[string]*schema.Schema{
 	"name": {
@@ -40,6 +49,18 @@ var viewSchema = map[string]*schema.Schema{
 		Default:     false,
 		Description: "Overwrites the View if it exists.",
 	},
+	"copy_grants": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Retains the access permissions from the original view when it is recreated using `or_replace`. Has no effect without `or_replace` and cannot be combined with `backup_on_replace`.",
+	},
+	"backup_on_replace": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When true and `or_replace` is set, an existing view is renamed to `<name>_bak_<timestamp>` before the new view is created, so that a change can be rolled back by hand. A change to `statement` then replaces the view in place rather than destroying it first, and the backup is renamed back if the new view cannot be created. The view is still dropped on destroy. Backups are never removed by the provider and have to be cleaned up manually.",
+	},
 	"is_secure": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -55,28 +76,185 @@ var viewSchema = map[string]*schema.Schema{
 		Type:             schema.TypeString,
 		Required:         true,
 		Description:      "Specifies the query used to create the view.",
-		ForceNew:         true,
-		DiffSuppressFunc: DiffSuppressStatement,
+		DiffSuppressFunc: DiffSuppressViewStatement,
+	},
+	"ignore_comments_in_statement": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When true, SQL comments (`--`, `//` and `/* */`) are removed from both the configured and the stored statement before they are compared, so that comments Snowflake strips or relocates do not cause a diff. Changes that only touch comments are then never applied.",
+	},
+	"normalized_statement": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The statement as normalized for comparison by the provider, with runs of whitespace collapsed, whitespace just inside parentheses and trailing semicolons removed and, if `ignore_comments_in_statement` is set, comments removed. String literals and quoted identifiers are left as is. A change to `statement` is ignored when it normalizes to the same text, ignoring the case of unquoted text. Useful to debug spurious diffs.",
+	},
+	"change_tracking": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether to enable change tracking on the view, which is required to create streams on it. It is changed in place and read back from SHOW VIEWS. The statement is checked for constructs streams on views do not support (GROUP BY, DISTINCT, window functions) and a warning is logged if any are found.",
+	},
+	"view_read_source": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "show",
+		Description:  "Where the view is read from on refresh: `show` (SHOW VIEWS) or `information_schema` (INFORMATION_SCHEMA.VIEWS of the database), for accounts where SHOW VIEWS is slow or restricted. Both populate the same attributes.",
+		ValidateFunc: validation.StringInSlice([]string{"show", "information_schema"}, false),
+	},
+	"adopt_identical": {
+		Type:          schema.TypeBool,
+		Optional:      true,
+		Default:       false,
+		ConflictsWith: []string{"or_replace"},
+		Description:   "When true and a view with the same name, statement, comment and `is_secure` already exists, it is adopted into the state instead of failing the creation. Useful to re-run an apply that failed after the view was created.",
+	},
+	"minimal_read": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When true, refreshes only check that the view exists and read its comment from INFORMATION_SCHEMA.VIEWS, taking precedence over `view_read_source`. The view text and `is_secure` are not read, so changes made to them outside Terraform are not detected. Meant for share-provider accounts with many views whose definition is managed elsewhere.",
+	},
+	"read_dependents": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When true, the objects referencing this view are read from SNOWFLAKE.ACCOUNT_USAGE.OBJECT_DEPENDENCIES into `dependents` and a warning is logged when the view is replaced or destroyed while it has dependents, see `fail_on_dependents` to fail instead. Requires IMPORTED PRIVILEGES on the SNOWFLAKE database.",
+	},
+	"fail_on_dependents": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When true together with `read_dependents`, a plan replacing the view because `database`, `schema` or `statement` changed fails while `dependents` is not empty, and so does destroying the view. Terraform does not plan destroys through the provider, so a destroy only fails on apply; set this to false and apply first to go ahead.",
+	},
+	"dependents": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The fully qualified names of the objects referencing this view. Only populated when `read_dependents` is true; note that ACCOUNT_USAGE may lag behind recent changes.",
 	},
 	"tag": tagReferenceSchema,
 }
 
+// normalizeQuery collapses runs of whitespace, removes whitespace just inside parentheses and drops
+// trailing semicolons. String literals, quoted identifiers and $$-delimited strings are kept as is.
 func normalizeQuery(str string) string {
-	return strings.TrimSpace(space.ReplaceAllString(str, " "))
+	str = mapUnquoted(str, func(s string) string {
+		s = space.ReplaceAllString(s, " ")
+		return spaceInParens.ReplaceAllStringFunc(s, strings.TrimSpace)
+	})
+	return strings.TrimRight(strings.TrimSpace(str), "; \t\r\n")
+}
+
+// mapUnquoted applies f to the parts of a statement outside single-quoted strings, double-quoted
+// identifiers and $$-delimited strings, and returns the statement with the quoted parts unchanged.
+func mapUnquoted(str string, f func(string) string) string {
+	var b strings.Builder
+	start := 0
+	for i := 0; i < len(str); {
+		end := -1
+		switch {
+		case str[i] == '\'' || str[i] == '"':
+			end = closingQuote(str, i)
+		case strings.HasPrefix(str[i:], "$$"):
+			end = len(str)
+			if j := strings.Index(str[i+2:], "$$"); j >= 0 {
+				end = i + 2 + j + 2
+			}
+		}
+		if end < 0 {
+			i++
+			continue
+		}
+		b.WriteString(f(str[start:i]))
+		b.WriteString(str[i:end])
+		start, i = end, end
+	}
+	b.WriteString(f(str[start:]))
+	return b.String()
 }
 
-// DiffSuppressStatement will suppress diffs between statemens if they differ in only case or in
-// runs of whitespace (\s+ = \s). This is needed because the snowflake api does not faithfully
-// round-trip queries so we cannot do a simple character-wise comparison to detect changes.
+// DiffSuppressStatement will suppress diffs between statements if they differ only in the case of
+// unquoted text, in runs of whitespace (\s+ = \s), in whitespace just inside parentheses
+// (`( x )` = `(x)`) or in trailing semicolons. This is needed because the snowflake api does not
+// faithfully round-trip queries so we cannot do a simple character-wise comparison to detect
+// changes. String literals, quoted identifiers and $$-delimited bodies have to match exactly, so
+// that e.g. changing `WHERE status = 'Settled'` to `'settled'` is not suppressed.
 //
-// Warnings: We will have false positives in cases where a change in case or run of whitespace is
-// semantically significant.
+// Warnings: We will have false positives in cases where a change in case or run of whitespace
+// outside quotes is semantically significant.
 //
 // If we can find a sql parser that can handle the snowflake dialect then we should switch to parsing
 // queries and either comparing ASTs or emitting a canonical serialization for comparison. I couldn't
 // find such a library.
 func DiffSuppressStatement(_, old, new string, d *schema.ResourceData) bool {
-	return strings.EqualFold(normalizeQuery(old), normalizeQuery(new))
+	return mapUnquoted(normalizeQuery(old), strings.ToUpper) == mapUnquoted(normalizeQuery(new), strings.ToUpper)
+}
+
+// DiffSuppressViewStatement behaves like DiffSuppressStatement, but also ignores SQL comments
+// when ignore_comments_in_statement is set on the view.
+func DiffSuppressViewStatement(k, old, new string, d *schema.ResourceData) bool {
+	if d != nil && d.Get("ignore_comments_in_statement").(bool) {
+		old, new = stripComments(old), stripComments(new)
+	}
+	return DiffSuppressStatement(k, old, new, d)
+}
+
+// stripComments replaces the line (-- and //) and block (/* */) comments in a statement with a
+// space. Comment markers inside single-quoted strings, double-quoted identifiers and $$-delimited
+// strings are left alone.
+func stripComments(str string) string {
+	var b strings.Builder
+	for i := 0; i < len(str); {
+		switch {
+		case str[i] == '\'' || str[i] == '"':
+			end := closingQuote(str, i)
+			b.WriteString(str[i:end])
+			i = end
+		case strings.HasPrefix(str[i:], "$$"):
+			end := len(str)
+			if j := strings.Index(str[i+2:], "$$"); j >= 0 {
+				end = i + 2 + j + 2
+			}
+			b.WriteString(str[i:end])
+			i = end
+		case strings.HasPrefix(str[i:], "--") || strings.HasPrefix(str[i:], "//"):
+			end := len(str)
+			if j := strings.IndexByte(str[i:], '\n'); j >= 0 {
+				end = i + j
+			}
+			b.WriteByte(' ')
+			i = end
+		case strings.HasPrefix(str[i:], "/*"):
+			end := len(str)
+			if j := strings.Index(str[i+2:], "*/"); j >= 0 {
+				end = i + 2 + j + 2
+			}
+			b.WriteByte(' ')
+			i = end
+		default:
+			b.WriteByte(str[i])
+			i++
+		}
+	}
+	return b.String()
+}
+
+// closingQuote returns the index just past the quote closing the string or identifier opened at
+// str[start]. Quotes are escaped by doubling them or, in strings, with a backslash.
+func closingQuote(str string, start int) int {
+	quote := str[start]
+	for i := start + 1; i < len(str); i++ {
+		switch {
+		case str[i] == '\\' && quote == '\'':
+			i++
+		case str[i] == quote && i+1 < len(str) && str[i+1] == quote:
+			i++
+		case str[i] == quote:
+			return i + 1
+		}
+	}
+	return len(str)
 }
 
 // View returns a pointer to the resource representing a view.
@@ -87,6 +265,11 @@ func View() *schema.Resource {
 		Update: UpdateView,
 		Delete: DeleteView,
 
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIf("statement", replaceViewStatementOnDestroy),
+			checkViewReplaceWithDependents,
+			validateViewCopyGrants,
+		),
 		Schema: viewSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -94,6 +277,54 @@ func View() *schema.Resource {
 	}
 }
 
+// checkViewReplaceWithDependents warns during plan when a change forces the view to be recreated
+// while other objects reference it, and fails the plan if fail_on_dependents is set. Terraform does
+// not run diff customization for destroy plans, so that case is checked in DeleteView instead.
+func checkViewReplaceWithDependents(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.Get("read_dependents").(bool) {
+		return nil
+	}
+	dependents := expandStringList(d.Get("dependents").([]interface{}))
+	if len(dependents) == 0 {
+		return nil
+	}
+	for _, k := range []string{"database", "schema", "statement"} {
+		if !d.HasChange(k) {
+			continue
+		}
+		if d.Get("fail_on_dependents").(bool) {
+			return fmt.Errorf("view %v would be replaced because %v changed, which may invalidate its dependents: %v; set fail_on_dependents to false to proceed", d.Id(), k, strings.Join(dependents, ", "))
+		}
+		log.Printf("[WARN] view %v will be replaced because %v changed, which may invalidate its dependents: %v", d.Id(), k, strings.Join(dependents, ", "))
+		return nil
+	}
+	return nil
+}
+
+// replaceViewStatementOnDestroy reports whether a change to the statement destroys the view and
+// creates it again. With backup_on_replace the view is replaced in place by UpdateView instead,
+// since destroying it first would leave nothing to back up.
+func replaceViewStatementOnDestroy(_ context.Context, d *schema.ResourceDiff, _ interface{}) bool {
+	return !(d.Get("or_replace").(bool) && d.Get("backup_on_replace").(bool))
+}
+
+// validateViewCopyGrants rejects copy_grants together with backup_on_replace: the backup renames
+// the view away before it is replaced, leaving no grants for COPY GRANTS to copy.
+func validateViewCopyGrants(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get("or_replace").(bool) && d.Get("copy_grants").(bool) && d.Get("backup_on_replace").(bool) {
+		return errors.New("copy_grants cannot be used with backup_on_replace, the grants stay on the backup of the view")
+	}
+	return nil
+}
+
+// warnOnChangeTrackingIncompatibilities logs a warning when the statement of a view with change
+// tracking enabled contains constructs not supported by streams on views.
+func warnOnChangeTrackingIncompatibilities(name, statement string) {
+	if found := snowflake.ChangeTrackingIncompatibilities(statement); len(found) > 0 {
+		log.Printf("[WARN] view %v has change_tracking enabled but its statement contains constructs not supported by streams on views: %v", name, strings.Join(found, ", "))
+	}
+}
+
 type ViewID struct {
 	DatabaseName string
 	SchemaName   string
@@ -157,6 +388,9 @@ func CreateView(d *schema.ResourceData, meta interface{}) error {
 	// Set optionals
 	if v, ok := d.GetOk("or_replace"); ok && v.(bool) {
 		builder.WithReplace()
+		if d.Get("copy_grants").(bool) {
+			builder.WithCopyGrants()
+		}
 	}
 
 	if v, ok := d.GetOk("is_secure"); ok && v.(bool) {
@@ -172,13 +406,49 @@ func CreateView(d *schema.ResourceData, meta interface{}) error {
 		builder.WithTags(tags.toSnowflakeTagValues())
 	}
 
-	q, err := builder.Create()
-	if err != nil {
-		return err
+	var backupName string
+	if d.Get("or_replace").(bool) && d.Get("backup_on_replace").(bool) {
+		var err error
+		if backupName, err = backupView(db, database, schema, name); err != nil {
+			return err
+		}
 	}
-	err = snowflake.Exec(db, q)
-	if err != nil {
-		return fmt.Errorf("error creating view %v", name)
+
+	adopted := false
+	if d.Get("adopt_identical").(bool) {
+		identical, err := identicalViewExists(db, d)
+		if err != nil {
+			return err
+		}
+		if identical {
+			log.Printf("[INFO] adopting existing view %v.%v.%v identical to the configuration", database, schema, name)
+			adopted = true
+		}
+	}
+
+	if !adopted {
+		q, err := builder.Create()
+		if err != nil {
+			return err
+		}
+		err = snowflake.Exec(db, q)
+		if err != nil {
+			if backupName != "" {
+				return restoreViewBackup(db, database, schema, name, backupName, err)
+			}
+			return fmt.Errorf("error creating view %v", name)
+		}
+	}
+
+	if v, ok := d.GetOk("change_tracking"); ok && v.(bool) {
+		warnOnChangeTrackingIncompatibilities(name, s)
+		q, err := builder.ChangeTracking(true)
+		if err != nil {
+			return err
+		}
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error enabling change tracking on view %v err = %w", name, err)
+		}
 	}
 
 	viewID := &ViewID{
@@ -206,7 +476,15 @@ func ReadView(d *schema.ResourceData, meta interface{}) error {
 	schema := viewID.SchemaName
 	view := viewID.ViewName
 
-	q := snowflake.NewViewBuilder(view).WithDB(dbName).WithSchema(schema).Show()
+	builder := snowflake.NewViewBuilder(view).WithDB(dbName).WithSchema(schema)
+	minimalRead := d.Get("minimal_read").(bool)
+	q := builder.Show()
+	switch {
+	case minimalRead:
+		q = builder.ShowMinimal()
+	case d.Get("view_read_source").(string) == "information_schema":
+		q = builder.ShowFromInformationSchema()
+	}
 	row := snowflake.QueryRow(db, q)
 	v, err := snowflake.ScanView(row)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -221,30 +499,59 @@ func ReadView(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("name", v.Name.String); err != nil {
 		return err
 	}
-	if err = d.Set("is_secure", v.IsSecure); err != nil {
-		return err
-	}
 	if err = d.Set("comment", v.Comment.String); err != nil {
 		return err
 	}
 	if err = d.Set("schema", v.SchemaName.String); err != nil {
 		return err
 	}
-
-	// Want to only capture the Select part of the query because before that is the Create part of the view which we no longer care about
-
-	extractor := snowflake.NewViewSelectStatementExtractor(v.Text.String)
-	substringOfQuery, err := extractor.Extract()
-	if err != nil {
+	if err = d.Set("database", v.DatabaseName.String); err != nil {
 		return err
 	}
-	if err = d.Set("statement", substringOfQuery); err != nil {
-		return err
+
+	// only SHOW VIEWS reports change tracking, the other reads leave it as configured
+	if v.ChangeTracking.Valid {
+		if err = d.Set("change_tracking", v.ChangeTracking.String == "ON"); err != nil {
+			return err
+		}
 	}
-	if err = d.Set("database", v.DatabaseName.String); err != nil {
-		return err
+
+	// a minimal read leaves the statement and is_secure as configured
+	if !minimalRead {
+		if err = d.Set("is_secure", v.IsSecure); err != nil {
+			return err
+		}
+
+		// Want to only capture the Select part of the query because before that is the Create part of the view which we no longer care about
+
+		extractor := snowflake.NewViewSelectStatementExtractor(v.Text.String)
+		substringOfQuery, err := extractor.Extract()
+		if err != nil {
+			return err
+		}
+		if err = d.Set("statement", substringOfQuery); err != nil {
+			return err
+		}
+		normalizedStatement := substringOfQuery
+		if d.Get("ignore_comments_in_statement").(bool) {
+			normalizedStatement = stripComments(normalizedStatement)
+		}
+		if err = d.Set("normalized_statement", normalizeQuery(normalizedStatement)); err != nil {
+			return err
+		}
 	}
-	return nil
+
+	var dependents []string
+	if d.Get("read_dependents").(bool) {
+		deps, err := snowflake.ListViewDependents(builder, db)
+		if err != nil {
+			return fmt.Errorf("error reading dependents of view %v err = %w", d.Id(), err)
+		}
+		for _, dep := range deps {
+			dependents = append(dependents, dep.QualifiedName())
+		}
+	}
+	return d.Set("dependents", dependents)
 }
 
 // UpdateView implements schema.UpdateFunc.
@@ -282,6 +589,12 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 		d.SetId(dataIDInput)
 	}
 
+	// the statement only changes in place with backup_on_replace, see replaceViewStatementOnDestroy;
+	// the view is then created again from the whole configuration
+	if d.HasChange("statement") {
+		return CreateView(d, meta)
+	}
+
 	if d.HasChange("comment") {
 		comment := d.Get("comment")
 
@@ -324,6 +637,19 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	}
+	if d.HasChange("change_tracking") {
+		changeTracking := d.Get("change_tracking").(bool)
+		if changeTracking {
+			warnOnChangeTrackingIncompatibilities(view, d.Get("statement").(string))
+		}
+		q, err := builder.ChangeTracking(changeTracking)
+		if err != nil {
+			return err
+		}
+		if err = snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating change tracking on view %v err = %w", d.Id(), err)
+		}
+	}
 	tagChangeErr := handleTagChanges(db, d, builder)
 	if tagChangeErr != nil {
 		return tagChangeErr
@@ -354,6 +680,66 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 	return ReadView(d, meta)
 }
 
+// identicalViewExists reports whether the view configured in d already exists with the same
+// normalized statement, comment and secure flag.
+func identicalViewExists(db *sql.DB, d *schema.ResourceData) (bool, error) {
+	builder := snowflake.NewViewBuilder(d.Get("name").(string)).WithDB(d.Get("database").(string)).WithSchema(d.Get("schema").(string))
+	v, err := snowflake.ScanView(snowflake.QueryRow(db, builder.Show()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if v.IsSecure != d.Get("is_secure").(bool) || v.Comment.String != d.Get("comment").(string) {
+		return false, nil
+	}
+
+	existing, err := snowflake.NewViewSelectStatementExtractor(v.Text.String).Extract()
+	if err != nil {
+		return false, err
+	}
+	return DiffSuppressViewStatement("statement", existing, d.Get("statement").(string), d), nil
+}
+
+// backupView renames the view to <name>_bak_<timestamp> so that it can be restored by hand and
+// returns the name of the backup. It does nothing and returns "" if the view does not exist.
+func backupView(db *sql.DB, database, schema, name string) (string, error) {
+	builder := snowflake.NewViewBuilder(name).WithDB(database).WithSchema(schema)
+	_, err := snowflake.ScanView(snowflake.QueryRow(db, builder.Show()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	backupName := fmt.Sprintf("%v_bak_%v", name, time.Now().UTC().Format("20060102150405"))
+	q, err := builder.Rename(backupName)
+	if err != nil {
+		return "", err
+	}
+	if err := snowflake.Exec(db, q); err != nil {
+		return "", fmt.Errorf("error backing up view %v to %v err = %w", name, backupName, err)
+	}
+	log.Printf("[INFO] view %v backed up to %v, the backup has to be dropped manually", name, backupName)
+	return backupName, nil
+}
+
+// restoreViewBackup renames the backup made by backupView back to name after createErr kept the
+// new view from being created, and returns the error to report.
+func restoreViewBackup(db *sql.DB, database, schema, name, backupName string, createErr error) error {
+	q, err := snowflake.NewViewBuilder(backupName).WithDB(database).WithSchema(schema).Rename(name)
+	if err == nil {
+		err = snowflake.Exec(db, q)
+	}
+	if err != nil {
+		return fmt.Errorf("error creating view %v err = %v, and restoring it from %v failed err = %w", name, createErr, backupName, err)
+	}
+	log.Printf("[INFO] view %v restored from %v", name, backupName)
+	return fmt.Errorf("error creating view %v, the previous view was restored err = %w", name, createErr)
+}
+
 // DeleteView implements schema.DeleteFunc.
 func DeleteView(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
@@ -365,6 +751,13 @@ func DeleteView(d *schema.ResourceData, meta interface{}) error {
 	schema := viewID.SchemaName
 	view := viewID.ViewName
 
+	if dependents := expandStringList(d.Get("dependents").([]interface{})); len(dependents) > 0 {
+		if d.Get("read_dependents").(bool) && d.Get("fail_on_dependents").(bool) {
+			return fmt.Errorf("view %v is referenced by: %v; set fail_on_dependents to false to drop it", d.Id(), strings.Join(dependents, ", "))
+		}
+		log.Printf("[WARN] dropping view %v which is referenced by: %v", d.Id(), strings.Join(dependents, ", "))
+	}
+
 	q, err := snowflake.NewViewBuilder(view).WithDB(dbName).WithSchema(schema).Drop()
 	if err != nil {
 		return err
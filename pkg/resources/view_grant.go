@@ -7,15 +7,12 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validViewPrivileges = NewPrivilegeSet(
-	privilegeOwnership,
-	privilegeReferences,
-	privilegeSelect,
-)
+var validViewPrivileges = privilegesFor("VIEW")
 
 var viewGrantSchema = map[string]*schema.Schema{
 	"view_name": {
@@ -52,7 +49,7 @@ var viewGrantSchema = map[string]*schema.Schema{
 	},
 	"shares": {
 		Type:        schema.TypeSet,
-		Elem:        &schema.Schema{Type: schema.TypeString},
+		Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: snowflakeValidation.ValidateShareName},
 		Optional:    true,
 		Description: "Grants privilege to these shares (only valid if on_future is unset).",
 	},
@@ -64,6 +61,7 @@ var viewGrantSchema = map[string]*schema.Schema{
 		ForceNew:      true,
 		ConflictsWith: []string{"view_name", "shares"},
 	},
+	"revoke_existing_on_delete": revokeExistingOnDeleteSchema,
 	"with_grant_option": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -77,6 +75,10 @@ var viewGrantSchema = map[string]*schema.Schema{
 		Description: "When this is set to true, multiple grants of the same type can be created. This will cause Terraform to not revoke grants applied to roles and objects outside Terraform.",
 		Default:     false,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 }
 
 // ViewGrant returns a pointer to the resource representing a view grant.
@@ -88,9 +90,12 @@ func ViewGrant() *TerraformGrantResource {
 			Delete: DeleteViewGrant,
 			Update: UpdateViewGrant,
 
-			Schema: viewGrantSchema,
+			Schema:        viewGrantSchema,
+			CustomizeDiff: validateBulkGrantSupport("VIEW"),
 			Importer: &schema.ResourceImporter{
-				StateContext: schema.ImportStatePassthroughContext,
+				StateContext: importFutureGrantWildcard(snowflake.FutureViewGrant, func(g *futureGrantImport) string {
+					return NewViewGrantID(g.DatabaseName, g.SchemaName, "", g.Privilege, g.Roles, []string{}, g.WithGrantOption).String()
+				}),
 			},
 		},
 		ValidPrivs: validViewPrivileges,
@@ -249,13 +254,13 @@ func UpdateViewGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	err = deleteGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, rolesToRevoke, sharesToRevoke)
+		meta, d.Get("as_role").(string), builder, grantID.Privilege, rolesToRevoke, sharesToRevoke)
 	if err != nil {
 		return err
 	}
 	// then add
 	err = createGenericGrantRolesAndShares(
-		meta, builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, sharesToAdd)
+		meta, d.Get("as_role").(string), d.Get("current_grants").(string), builder, grantID.Privilege, grantID.WithGrantOption, rolesToAdd, sharesToAdd)
 	if err != nil {
 		return err
 	}
@@ -1,7 +1,10 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,6 +13,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
 	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/snowflakedb/gosnowflake"
 	"github.com/stretchr/testify/require"
 )
 
@@ -45,6 +49,69 @@ func TestViewGrantCreate(t *testing.T) {
 	})
 }
 
+func TestViewGrantCreateAsRole(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"view_name":     "test-view",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+		"as_role":       "SECURITYADMIN",
+	}
+	d := schema.TestResourceDataRaw(t, resources.ViewGrant().Resource.Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SELECT CURRENT_ROLE\(\)$`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ROLE()"}).AddRow("SYSADMIN"))
+		mock.ExpectExec(`^USE ROLE "SECURITYADMIN"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^GRANT SELECT ON VIEW "test-db"."PUBLIC"."test-view" TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^USE ROLE "SYSADMIN"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadViewGrant(mock)
+		err := resources.CreateViewGrant(d, db)
+		r.NoError(err)
+	})
+}
+
+func TestViewGrantOwnershipRevokeCurrentGrants(t *testing.T) {
+	r := require.New(t)
+
+	ownership := schema.TestResourceDataRaw(t, resources.ViewGrant().Resource.Schema, map[string]interface{}{
+		"view_name":      "test-view",
+		"schema_name":    "PUBLIC",
+		"database_name":  "test-db",
+		"privilege":      "OWNERSHIP",
+		"roles":          []interface{}{"test-owner"},
+		"current_grants": "REVOKE",
+	})
+	selectGrant := viewGrant(t, "test-db❄️PUBLIC❄️test-view❄️SELECT❄️false❄️test-role-1❄️", map[string]interface{}{
+		"view_name":     "test-view",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.MatchExpectationsInOrder(true)
+		mock.ExpectExec(`^GRANT OWNERSHIP ON VIEW "test-db"."PUBLIC"."test-view" TO ROLE "test-owner" REVOKE CURRENT GRANTS$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		// the transfer removed SELECT from test-role-1
+		rows := sqlmock.NewRows([]string{
+			"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+		}).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "OWNERSHIP", "VIEW", "test-view", "ROLE", "test-owner", false, "bob",
+		)
+		mock.ExpectQuery(`^SHOW GRANTS ON VIEW "test-db"."PUBLIC"."test-view"$`).WillReturnRows(rows)
+		r.NoError(resources.CreateViewGrant(ownership, db))
+
+		// the other grant resource on the view drops the revoked role from its state, so the next plan
+		// grants it again
+		r.NoError(resources.ReadViewGrant(selectGrant, db))
+		r.Equal(0, selectGrant.Get("roles").(*schema.Set).Len())
+	})
+}
+
 func TestViewGrantRead(t *testing.T) {
 	r := require.New(t)
 
@@ -77,6 +144,35 @@ func TestViewGrantRead(t *testing.T) {
 	r.Equal(2, shares.Len())
 }
 
+func TestViewGrantReadQualifiedShare(t *testing.T) {
+	r := require.New(t)
+
+	d := viewGrant(t, "test-db|PUBLIC|test-view|SELECT||false", map[string]interface{}{
+		"view_name":         "test-view",
+		"schema_name":       "PUBLIC",
+		"database_name":     "test-db",
+		"privilege":         "SELECT",
+		"roles":             []interface{}{},
+		"shares":            []interface{}{"ab12345.test-share-1"},
+		"with_grant_option": false,
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+		}).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "SELECT", "VIEW", "test-view", "SHARE", "AB12345.TEST-SHARE-1", false, "bob",
+		)
+		mock.ExpectQuery(`^SHOW GRANTS ON VIEW "test-db"."PUBLIC"."test-view"$`).WillReturnRows(rows)
+		err := resources.ReadViewGrant(d, db)
+		r.NoError(err)
+	})
+
+	shares := d.Get("shares").(*schema.Set)
+	r.True(shares.Contains("ab12345.test-share-1"))
+	r.Equal(1, shares.Len())
+}
+
 func expectReadViewGrant(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{
 		"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
@@ -151,6 +247,171 @@ func TestFutureViewGrantCreate(t *testing.T) {
 	})
 }
 
+func TestFutureViewGrantDeleteRevokeExisting(t *testing.T) {
+	r := require.New(t)
+
+	d := viewGrant(t, "test-db❄️PUBLIC❄️❄️SELECT❄️false❄️test-role-1❄️", map[string]interface{}{
+		"on_future":                 true,
+		"schema_name":               "PUBLIC",
+		"database_name":             "test-db",
+		"privilege":                 "SELECT",
+		"roles":                     []interface{}{"test-role-1"},
+		"revoke_existing_on_delete": true,
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(
+			`^REVOKE SELECT ON FUTURE VIEWS IN SCHEMA "test-db"."PUBLIC" FROM ROLE "test-role-1"$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectExec(
+			`^REVOKE SELECT ON ALL VIEWS IN SCHEMA "test-db"."PUBLIC" FROM ROLE "test-role-1"$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := resources.DeleteViewGrant(d, db)
+		r.NoError(err)
+		r.Equal("", d.Id())
+	})
+}
+
+func TestViewGrantDeleteDroppedView(t *testing.T) {
+	r := require.New(t)
+
+	d := viewGrant(t, "test-db❄️PUBLIC❄️test-view❄️SELECT❄️false❄️test-role-1,test-role-2❄️", map[string]interface{}{
+		"view_name":     "test-view",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1", "test-role-2"},
+	})
+
+	viewNotExist := &gosnowflake.SnowflakeError{
+		Number:  2003,
+		Message: "SQL compilation error:\nView 'TEST-DB.PUBLIC.TEST-VIEW' does not exist or not authorized.",
+	}
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		for i := 0; i < 2; i++ {
+			mock.ExpectBegin()
+			mock.ExpectExec(
+				`^REVOKE SELECT ON VIEW "test-db"."PUBLIC"."test-view" FROM ROLE "test-role-[12]"$`,
+			).WillReturnError(viewNotExist)
+			mock.ExpectRollback()
+		}
+		mock.ExpectQuery(`^SHOW GRANTS ON VIEW "test-db"."PUBLIC"."test-view"$`).WillReturnError(viewNotExist)
+		err := resources.DeleteViewGrant(d, db)
+		r.NoError(err)
+		r.Equal("", d.Id())
+	})
+}
+
+func TestViewGrantDeleteDroppedRole(t *testing.T) {
+	r := require.New(t)
+
+	d := viewGrant(t, "test-db❄️PUBLIC❄️test-view❄️SELECT❄️false❄️test-role-1,test-role-2❄️", map[string]interface{}{
+		"view_name":     "test-view",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1", "test-role-2"},
+	})
+	roles := d.Get("roles").(*schema.Set).List()
+	dropped, remaining := roles[0].(string), roles[1].(string)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(
+			fmt.Sprintf(`^REVOKE SELECT ON VIEW "test-db"."PUBLIC"."test-view" FROM ROLE "%v"$`, dropped),
+		).WillReturnError(&gosnowflake.SnowflakeError{
+			Number:  2003,
+			Message: fmt.Sprintf("SQL compilation error:\nRole '%v' does not exist or not authorized.", strings.ToUpper(dropped)),
+		})
+		mock.ExpectRollback()
+		mock.ExpectBegin()
+		mock.ExpectExec(
+			fmt.Sprintf(`^REVOKE SELECT ON VIEW "test-db"."PUBLIC"."test-view" FROM ROLE "%v"$`, remaining),
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectQuery(`^SHOW GRANTS ON VIEW "test-db"."PUBLIC"."test-view"$`).WillReturnRows(sqlmock.NewRows([]string{
+			"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+		}))
+		err := resources.DeleteViewGrant(d, db)
+		r.ErrorContains(err, "does not exist or not authorized")
+		r.NotEqual("", d.Id())
+	})
+}
+
+func TestViewGrantDeleteOtherErrors(t *testing.T) {
+	r := require.New(t)
+
+	d := viewGrant(t, "test-db❄️PUBLIC❄️test-view❄️SELECT❄️false❄️test-role-1❄️", map[string]interface{}{
+		"view_name":     "test-view",
+		"schema_name":   "PUBLIC",
+		"database_name": "test-db",
+		"privilege":     "SELECT",
+		"roles":         []interface{}{"test-role-1"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(
+			`^REVOKE SELECT ON VIEW "test-db"."PUBLIC"."test-view" FROM ROLE "test-role-1"$`,
+		).WillReturnError(&gosnowflake.SnowflakeError{
+			Number:  3001,
+			Message: "SQL access control error:\nInsufficient privileges to operate on view 'TEST-VIEW'",
+		})
+		mock.ExpectRollback()
+		err := resources.DeleteViewGrant(d, db)
+		r.ErrorContains(err, "Insufficient privileges")
+		r.NotEqual("", d.Id())
+	})
+}
+
+func futureViewGrantRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"created_on", "privilege", "grant_on", "name", "grant_to", "grantee_name", "grant_option",
+	})
+}
+
+func TestFutureViewGrantImportWildcard(t *testing.T) {
+	r := require.New(t)
+
+	d := viewGrant(t, "test-db|PUBLIC|*|SELECT|future", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := futureViewGrantRows().AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "SELECT", "VIEW", "test-db.PUBLIC.<VIEW>", "ROLE", "test-role-2", false,
+		).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "SELECT", "VIEW", "test-db.PUBLIC.<VIEW>", "ROLE", "test-role-1", false,
+		).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "SELECT", "TABLE", "test-db.PUBLIC.<TABLE>", "ROLE", "test-role-3", true,
+		)
+		mock.ExpectQuery(`^SHOW FUTURE GRANTS IN SCHEMA "test-db"."PUBLIC"$`).WillReturnRows(rows)
+
+		imported, err := resources.ViewGrant().Resource.Importer.StateContext(context.Background(), d, db)
+		r.NoError(err)
+		r.Len(imported, 1)
+		r.Equal("test-db❄️PUBLIC❄️❄️SELECT❄️false❄️test-role-1,test-role-2❄️", imported[0].Id())
+	})
+}
+
+func TestFutureViewGrantImportWildcardAmbiguous(t *testing.T) {
+	r := require.New(t)
+
+	d := viewGrant(t, "test-db||*|SELECT|future", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := futureViewGrantRows().AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "SELECT", "VIEW", "test-db.<VIEW>", "ROLE", "test-role-1", true,
+		).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "SELECT", "VIEW", "test-db.<VIEW>", "ROLE", "test-role-2", false,
+		)
+		mock.ExpectQuery(`^SHOW FUTURE GRANTS IN DATABASE "test-db"$`).WillReturnRows(rows)
+
+		_, err := resources.ViewGrant().Resource.Importer.StateContext(context.Background(), d, db)
+		r.ErrorContains(err, "ambiguous future VIEW grants of SELECT")
+	})
+}
+
 func expectReadFutureViewGrant(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{
 		"created_on", "privilege", "grant_on", "name", "grant_to", "grantee_name", "grant_option",
@@ -0,0 +1,37 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var viewRowAccessPolicyAttachmentSchema = rowAccessPolicyAttachmentSchema("VIEW", "view_name")
+
+// ViewRowAccessPolicyAttachment returns a pointer to the resource representing a row access policy
+// attached to a view.
+func ViewRowAccessPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateViewRowAccessPolicyAttachment,
+		Read:   ReadViewRowAccessPolicyAttachment,
+		Delete: DeleteViewRowAccessPolicyAttachment,
+
+		Schema: viewRowAccessPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateViewRowAccessPolicyAttachment implements schema.CreateFunc.
+func CreateViewRowAccessPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	return createRowAccessPolicyAttachment(d, meta, "VIEW", "view_name")
+}
+
+// ReadViewRowAccessPolicyAttachment implements schema.ReadFunc.
+func ReadViewRowAccessPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	return readRowAccessPolicyAttachment(d, meta, "VIEW", "view_name")
+}
+
+// DeleteViewRowAccessPolicyAttachment implements schema.DeleteFunc.
+func DeleteViewRowAccessPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	return deleteRowAccessPolicyAttachment(d, meta, "VIEW")
+}
@@ -1,8 +1,11 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -12,6 +15,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
 	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 )
 
@@ -72,6 +76,55 @@ func TestViewCreateOrReplace(t *testing.T) {
 	})
 }
 
+func TestViewCreateOrReplaceCopyGrants(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":        "good_name",
+		"database":    "test_db",
+		"schema":      "test_schema",
+		"comment":     "great comment",
+		"statement":   "SELECT * FROM test_db.PUBLIC.GREAT_TABLE WHERE account_id = 'bobs-account-id'",
+		"is_secure":   true,
+		"or_replace":  true,
+		"copy_grants": true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.View().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE OR REPLACE SECURE VIEW "test_db"."test_schema"."good_name" COPY GRANTS COMMENT = 'great comment' AS SELECT \* FROM test_db.PUBLIC.GREAT_TABLE WHERE account_id = 'bobs-account-id'$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		expectReadView(mock)
+		err := resources.CreateView(d, db)
+		r.NoError(err)
+		r.True(d.Get("copy_grants").(bool))
+	})
+}
+
+func TestViewCopyGrantsRejectsBackupOnReplace(t *testing.T) {
+	r := require.New(t)
+
+	res := resources.View()
+	in := map[string]interface{}{
+		"name":              "good_name",
+		"database":          "test_db",
+		"schema":            "test_schema",
+		"statement":         "SELECT 1",
+		"or_replace":        true,
+		"copy_grants":       true,
+		"backup_on_replace": true,
+	}
+	_, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(in), nil)
+	r.ErrorContains(err, "copy_grants cannot be used with backup_on_replace")
+
+	in["backup_on_replace"] = false
+	_, err = res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(in), nil)
+	r.NoError(err)
+}
+
 func TestViewCreateAmpersand(t *testing.T) {
 	r := require.New(t)
 
@@ -97,6 +150,94 @@ func TestViewCreateAmpersand(t *testing.T) {
 	})
 }
 
+func TestViewCreateWithChangeTracking(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":            "good_name",
+		"database":        "test_db",
+		"schema":          "test_schema",
+		"comment":         "great comment",
+		"statement":       "SELECT * FROM test_db.PUBLIC.GREAT_TABLE WHERE account_id = 'bobs-account-id'",
+		"is_secure":       true,
+		"change_tracking": true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.View().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE SECURE VIEW "test_db"."test_schema"."good_name" COMMENT = 'great comment' AS SELECT \* FROM test_db.PUBLIC.GREAT_TABLE WHERE account_id = 'bobs-account-id'$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(
+			`^ALTER VIEW "test_db"."test_schema"."good_name" SET CHANGE_TRACKING = TRUE$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		expectReadView(mock)
+		err := resources.CreateView(d, db)
+		r.NoError(err)
+	})
+}
+
+func TestViewCreateAdoptsIdentical(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":            "good_name",
+		"database":        "test_db",
+		"schema":          "test_schema",
+		"comment":         "great comment",
+		"statement":       "select *  from test_db.PUBLIC.GREAT_TABLE\nWHERE account_id = 'bobs-account-id'",
+		"is_secure":       true,
+		"adopt_identical": true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.View().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.MatchExpectationsInOrder(true)
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "reserved", "database_name", "schema_name", "owner", "comment", "text", "is_secure", "is_materialized",
+		}).AddRow("2019-05-19 16:55:36.530 -0700", "good_name", "", "test_db", "test_schema", "admin", "great comment", `CREATE SECURE VIEW "test_db"."test_schema"."good_name" COMMENT = 'great comment' AS SELECT * FROM test_db.PUBLIC.GREAT_TABLE WHERE account_id = 'bobs-account-id'`, true, false)
+		mock.ExpectQuery(`^SHOW VIEWS LIKE 'good_name' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+		expectReadView(mock)
+		err := resources.CreateView(d, db)
+		r.NoError(err)
+		r.Equal("test_db|test_schema|good_name", d.Id())
+	})
+}
+
+func TestViewCreateAdoptIdenticalCreatesWhenDifferent(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":            "good_name",
+		"database":        "test_db",
+		"schema":          "test_schema",
+		"comment":         "great comment",
+		"statement":       "SELECT * FROM test_db.PUBLIC.GREAT_TABLE WHERE account_id = 'bobs-account-id'",
+		"is_secure":       true,
+		"adopt_identical": true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.View().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.MatchExpectationsInOrder(true)
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "reserved", "database_name", "schema_name", "owner", "comment", "text", "is_secure", "is_materialized",
+		}).AddRow("2019-05-19 16:55:36.530 -0700", "good_name", "", "test_db", "test_schema", "admin", "other comment", `CREATE SECURE VIEW "test_db"."test_schema"."good_name" AS SELECT * FROM test_db.PUBLIC.GREAT_TABLE WHERE account_id = 'bobs-account-id'`, true, false)
+		mock.ExpectQuery(`^SHOW VIEWS LIKE 'good_name' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+		mock.ExpectExec(
+			`^CREATE SECURE VIEW "test_db"."test_schema"."good_name" COMMENT = 'great comment' AS SELECT \* FROM test_db.PUBLIC.GREAT_TABLE WHERE account_id = 'bobs-account-id'$`,
+		).WillReturnError(errors.New("object already exists"))
+
+		err := resources.CreateView(d, db)
+		r.EqualError(err, "error creating view good_name")
+	})
+}
+
 func expectReadView(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{
 		"created_on", "name", "reserved", "database_name", "schema_name", "owner", "comment", "text", "is_secure", "is_materialized",
@@ -120,6 +261,12 @@ func TestDiffSuppressStatement(t *testing.T) {
 		{"select", args{"", "select * from foo;", "select * from foo;", nil}, true},
 		{"view 1", args{"", testhelpers.MustFixture(t, "view_1a.sql"), testhelpers.MustFixture(t, "view_1b.sql"), nil}, true},
 		{"view 2", args{"", testhelpers.MustFixture(t, "view_2a.sql"), testhelpers.MustFixture(t, "view_2b.sql"), nil}, true},
+		{"udtf", args{"", testhelpers.MustFixture(t, "view_3a.sql"), testhelpers.MustFixture(t, "view_3b.sql"), nil}, true},
+		{"trailing semicolon", args{"", "select * from foo;", "select * from foo ;\n", nil}, true},
+		{"predicate literal changed", args{"", testhelpers.MustFixture(t, "view_5a.sql"), testhelpers.MustFixture(t, "view_5b.sql"), nil}, false},
+		{"predicate literal unchanged", args{"", testhelpers.MustFixture(t, "view_5a.sql"), strings.NewReplacer("'settled'", "'Settled'", "'EU West'", "'EU  West'").Replace(testhelpers.MustFixture(t, "view_5b.sql")), nil}, true},
+		{"quoted identifier case changed", args{"", `SELECT "Status" FROM payments`, `SELECT "STATUS" FROM payments`, nil}, false},
+		{"udtf arguments changed", args{"", testhelpers.MustFixture(t, "view_3a.sql"), "SELECT t.ACCOUNT_ID, t.SCORE FROM TABLE(ANALYTICS.PUBLIC.SCORE_ACCOUNTS(TO_DATE('2023-01-01'), 'weekly')) t WHERE t.SCORE > 0.5", nil}, false},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -131,6 +278,22 @@ func TestDiffSuppressStatement(t *testing.T) {
 	}
 }
 
+func TestDiffSuppressViewStatementIgnoringComments(t *testing.T) {
+	r := require.New(t)
+	commented := testhelpers.MustFixture(t, "view_4a.sql")
+	plain := testhelpers.MustFixture(t, "view_4b.sql")
+
+	in := map[string]interface{}{"ignore_comments_in_statement": true}
+	d := schema.TestResourceDataRaw(t, resources.View().Schema, in)
+	r.True(resources.DiffSuppressViewStatement("statement", commented, plain, d))
+
+	// comment markers inside string literals are kept, so a changed literal is still a diff
+	r.False(resources.DiffSuppressViewStatement("statement", commented, strings.Replace(plain, "'-- not a comment'", "'--'", 1), d))
+
+	d = schema.TestResourceDataRaw(t, resources.View().Schema, map[string]interface{}{})
+	r.False(resources.DiffSuppressViewStatement("statement", commented, plain, d))
+}
+
 func TestViewRead(t *testing.T) {
 	r := require.New(t)
 
@@ -153,3 +316,367 @@ func TestViewRead(t *testing.T) {
 		r.Nil(err)
 	})
 }
+
+func TestViewReadChangeTracking(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":     "good_name",
+		"database": "test_db",
+		"schema":   "test_schema",
+	}
+
+	d := view(t, "test_db|test_schema|good_name", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "reserved", "database_name", "schema_name", "owner", "comment", "text", "is_secure", "is_materialized", "change_tracking",
+		},
+		).AddRow("2019-05-19 16:55:36.530 -0700", "good_name", "", "test_db", "test_schema", "admin", "great comment", "SELECT * FROM test_db.GREAT_SCHEMA.GREAT_TABLE", false, false, "ON")
+		mock.ExpectQuery(`^SHOW VIEWS LIKE 'good_name' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+		err := resources.ReadView(d, db)
+		r.NoError(err)
+		r.True(d.Get("change_tracking").(bool))
+	})
+}
+
+func TestViewUpdateChangeTracking(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":            "good_name",
+		"database":        "test_db",
+		"schema":          "test_schema",
+		"comment":         "great comment",
+		"statement":       "SELECT * FROM test_db.GREAT_SCHEMA.GREAT_TABLE WHERE account_id = 'bobs-account-id'",
+		"is_secure":       true,
+		"change_tracking": true,
+	}
+	out := map[string]interface{}{}
+	for k, v := range in {
+		out[k] = v
+	}
+	out["change_tracking"] = false
+
+	d := viewUpdate(t, "test_db|test_schema|good_name", in, out)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// the view is altered in place rather than recreated
+		mock.ExpectExec(`^ALTER VIEW "test_db"."test_schema"."good_name" SET CHANGE_TRACKING = FALSE$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadView(mock)
+		err := resources.UpdateView(d, db)
+		r.NoError(err)
+		r.False(d.Get("change_tracking").(bool))
+	})
+}
+
+func TestViewReadNormalizedStatement(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":     "good_name",
+		"database": "test_db",
+		"schema":   "test_schema",
+	}
+
+	d := view(t, "test_db|test_schema|good_name", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "reserved", "database_name", "schema_name", "owner", "comment", "text", "is_secure", "is_materialized",
+		},
+		).AddRow("2019-05-19 16:55:36.530 -0700", "good_name", "", "test_db", "test_schema", "admin", "great comment", "CREATE VIEW good_name AS SELECT *\n\tFROM   test_db.GREAT_SCHEMA.GREAT_TABLE  ", false, false)
+		mock.ExpectQuery(`^SHOW VIEWS LIKE 'good_name' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+		err := resources.ReadView(d, db)
+		r.NoError(err)
+		r.Equal("SELECT * FROM test_db.GREAT_SCHEMA.GREAT_TABLE", d.Get("normalized_statement"))
+	})
+}
+
+func TestViewReadFromInformationSchema(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":             "good_name",
+		"database":         "test_db",
+		"schema":           "test_schema",
+		"view_read_source": "information_schema",
+	}
+
+	d := view(t, "test_db|test_schema|good_name", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"name", "schema_name", "database_name", "comment", "text", "is_secure"}).
+			AddRow("good_name", "test_schema", "test_db", "great comment", "CREATE SECURE VIEW good_name AS SELECT * FROM test_db.GREAT_SCHEMA.GREAT_TABLE", true)
+		mock.ExpectQuery(`^SELECT .* FROM "test_db".INFORMATION_SCHEMA.VIEWS WHERE TABLE_SCHEMA = 'test_schema' AND TABLE_NAME = 'good_name'$`).WillReturnRows(rows)
+
+		err := resources.ReadView(d, db)
+		r.NoError(err)
+		r.Equal("good_name", d.Get("name"))
+		r.Equal("test_db", d.Get("database"))
+		r.Equal("test_schema", d.Get("schema"))
+		r.Equal("great comment", d.Get("comment"))
+		r.Equal(true, d.Get("is_secure"))
+		r.Equal("SELECT * FROM test_db.GREAT_SCHEMA.GREAT_TABLE", d.Get("statement"))
+	})
+}
+
+func TestViewMinimalRead(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":         "good_name",
+		"database":     "test_db",
+		"schema":       "test_schema",
+		"statement":    "SELECT * FROM test_db.GREAT_SCHEMA.GREAT_TABLE",
+		"is_secure":    true,
+		"minimal_read": true,
+	}
+
+	d := view(t, "test_db|test_schema|good_name", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"name", "schema_name", "database_name", "comment"}).
+			AddRow("good_name", "test_schema", "test_db", "great comment")
+		mock.ExpectQuery(`^SELECT TABLE_NAME AS "name", TABLE_SCHEMA AS "schema_name", TABLE_CATALOG AS "database_name", COMMENT AS "comment" FROM "test_db".INFORMATION_SCHEMA.VIEWS WHERE TABLE_SCHEMA = 'test_schema' AND TABLE_NAME = 'good_name'$`).WillReturnRows(rows)
+
+		err := resources.ReadView(d, db)
+		r.NoError(err)
+		r.Equal("good_name", d.Get("name"))
+		r.Equal("great comment", d.Get("comment"))
+		r.Equal(true, d.Get("is_secure"))
+		r.Equal("SELECT * FROM test_db.GREAT_SCHEMA.GREAT_TABLE", d.Get("statement"))
+	})
+}
+
+func TestViewReadDependents(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":            "good_name",
+		"database":        "test_db",
+		"schema":          "test_schema",
+		"read_dependents": true,
+	}
+
+	d := view(t, "test_db|test_schema|good_name", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadView(mock)
+		rows := sqlmock.NewRows([]string{
+			"referencing_database", "referencing_schema", "referencing_object_name", "referencing_object_domain",
+		}).AddRow("test_db", "test_schema", "other_view", "VIEW")
+		mock.ExpectQuery(`^SELECT .* FROM SNOWFLAKE.ACCOUNT_USAGE.OBJECT_DEPENDENCIES WHERE REFERENCED_DATABASE = 'test_db' AND REFERENCED_SCHEMA = 'test_schema' AND REFERENCED_OBJECT_NAME = 'good_name' AND REFERENCED_OBJECT_DOMAIN = 'VIEW'`).WillReturnRows(rows)
+
+		err := resources.ReadView(d, db)
+		r.NoError(err)
+		r.Equal([]interface{}{"test_db.test_schema.other_view"}, d.Get("dependents"))
+	})
+}
+
+func TestViewReplaceWithDependents(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":            "good_name",
+		"database":        "test_db",
+		"schema":          "test_schema",
+		"statement":       "SELECT * FROM test_db.GREAT_SCHEMA.GREAT_TABLE",
+		"read_dependents": true,
+	}
+	out := map[string]interface{}{}
+	for k, v := range in {
+		out[k] = v
+	}
+	out["statement"] = "SELECT * FROM test_db.GREAT_SCHEMA.OTHER_TABLE"
+
+	state := func() *terraform.InstanceState {
+		d := view(t, "test_db|test_schema|good_name", in)
+		r.NoError(d.Set("dependents", []string{"test_db.test_schema.other_view"}))
+		return d.State()
+	}
+
+	// only a warning is logged by default
+	diff, err := resources.View().Diff(context.Background(), state(), terraform.NewResourceConfigRaw(out), nil)
+	r.NoError(err)
+	r.True(diff.RequiresNew())
+
+	in["fail_on_dependents"], out["fail_on_dependents"] = true, true
+	_, err = resources.View().Diff(context.Background(), state(), terraform.NewResourceConfigRaw(out), nil)
+	r.ErrorContains(err, "view test_db|test_schema|good_name would be replaced because statement changed, which may invalidate its dependents: test_db.test_schema.other_view")
+
+	// changes that do not replace the view are not blocked
+	out["statement"] = in["statement"]
+	out["comment"] = "new comment"
+	_, err = resources.View().Diff(context.Background(), state(), terraform.NewResourceConfigRaw(out), nil)
+	r.NoError(err)
+}
+
+func TestViewDeleteWithDependents(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":               "good_name",
+		"database":           "test_db",
+		"schema":             "test_schema",
+		"statement":          "SELECT 1",
+		"read_dependents":    true,
+		"fail_on_dependents": true,
+	}
+	d := view(t, "test_db|test_schema|good_name", in)
+	r.NoError(d.Set("dependents", []string{"test_db.test_schema.other_view"}))
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		err := resources.DeleteView(d, db)
+		r.ErrorContains(err, "view test_db|test_schema|good_name is referenced by: test_db.test_schema.other_view")
+		r.Equal("test_db|test_schema|good_name", d.Id())
+	})
+
+	r.NoError(d.Set("fail_on_dependents", false))
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP VIEW "test_db"."test_schema"."good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := resources.DeleteView(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func TestViewCreateOrReplaceWithBackup(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":              "good_name",
+		"database":          "test_db",
+		"schema":            "test_schema",
+		"comment":           "great comment",
+		"statement":         "SELECT * FROM test_db.PUBLIC.GREAT_TABLE WHERE account_id = 'bobs-account-id'",
+		"is_secure":         true,
+		"or_replace":        true,
+		"backup_on_replace": true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.View().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.MatchExpectationsInOrder(true)
+		expectReadView(mock)
+		mock.ExpectExec(
+			`^ALTER VIEW "test_db"."test_schema"."good_name" RENAME TO "test_db"."test_schema"."good_name_bak_\d{14}"$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(
+			`^CREATE OR REPLACE SECURE VIEW "test_db"."test_schema"."good_name" COMMENT = 'great comment' AS SELECT \* FROM test_db.PUBLIC.GREAT_TABLE WHERE account_id = 'bobs-account-id'$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		expectReadView(mock)
+		err := resources.CreateView(d, db)
+		r.NoError(err)
+	})
+}
+
+func TestViewStatementChangeWithBackupReplacesInPlace(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":              "good_name",
+		"database":          "test_db",
+		"schema":            "test_schema",
+		"comment":           "great comment",
+		"statement":         "SELECT * FROM test_db.GREAT_SCHEMA.GREAT_TABLE WHERE account_id = 'bobs-account-id'",
+		"is_secure":         true,
+		"or_replace":        true,
+		"backup_on_replace": true,
+	}
+	out := map[string]interface{}{}
+	for k, v := range in {
+		out[k] = v
+	}
+	out["statement"] = "SELECT * FROM test_db.GREAT_SCHEMA.OTHER_TABLE"
+
+	// destroying the view first would leave nothing to back up
+	state := view(t, "test_db|test_schema|good_name", in).State()
+	diff, err := resources.View().Diff(context.Background(), state, terraform.NewResourceConfigRaw(out), nil)
+	r.NoError(err)
+	r.False(diff.RequiresNew())
+
+	in["backup_on_replace"], out["backup_on_replace"] = false, false
+	state = view(t, "test_db|test_schema|good_name", in).State()
+	diff, err = resources.View().Diff(context.Background(), state, terraform.NewResourceConfigRaw(out), nil)
+	r.NoError(err)
+	r.True(diff.RequiresNew())
+
+	in["backup_on_replace"], out["backup_on_replace"] = true, true
+	d := viewUpdate(t, "test_db|test_schema|good_name", in, out)
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.MatchExpectationsInOrder(true)
+		expectReadView(mock)
+		mock.ExpectExec(
+			`^ALTER VIEW "test_db"."test_schema"."good_name" RENAME TO "test_db"."test_schema"."good_name_bak_\d{14}"$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(
+			`^CREATE OR REPLACE SECURE VIEW "test_db"."test_schema"."good_name" COMMENT = 'great comment' AS SELECT \* FROM test_db.GREAT_SCHEMA.OTHER_TABLE$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadView(mock)
+
+		err := resources.UpdateView(d, db)
+		r.NoError(err)
+	})
+}
+
+func TestViewCreateFailureRestoresBackup(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":              "good_name",
+		"database":          "test_db",
+		"schema":            "test_schema",
+		"comment":           "great comment",
+		"statement":         "SELECT * FROM test_db.PUBLIC.MISSING_TABLE",
+		"is_secure":         true,
+		"or_replace":        true,
+		"backup_on_replace": true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.View().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.MatchExpectationsInOrder(true)
+		expectReadView(mock)
+		mock.ExpectExec(
+			`^ALTER VIEW "test_db"."test_schema"."good_name" RENAME TO "test_db"."test_schema"."good_name_bak_\d{14}"$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(
+			`^CREATE OR REPLACE SECURE VIEW "test_db"."test_schema"."good_name"`,
+		).WillReturnError(errors.New("Object 'MISSING_TABLE' does not exist or not authorized"))
+		mock.ExpectExec(
+			`^ALTER VIEW "test_db"."test_schema"."good_name_bak_\d{14}" RENAME TO "test_db"."test_schema"."good_name"$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := resources.CreateView(d, db)
+		r.ErrorContains(err, "error creating view good_name, the previous view was restored")
+	})
+	r.Empty(d.Id())
+}
+
+func TestViewDeleteWithBackupDropsView(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":              "good_name",
+		"database":          "test_db",
+		"schema":            "test_schema",
+		"statement":         "SELECT 1",
+		"backup_on_replace": true,
+	}
+	d := view(t, "test_db|test_schema|good_name", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP VIEW "test_db"."test_schema"."good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := resources.DeleteView(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
@@ -13,14 +13,13 @@ import (
 )
 
 // warehouseCreateProperties are only available via the CREATE statement.
-var warehouseCreateProperties = []string{"initially_suspended", "wait_for_provisioning"}
+var warehouseCreateProperties = []string{"initially_suspended", "wait_for_provisioning", "warehouse_type"}
 
 var warehouseProperties = []string{
 	"comment", "warehouse_size", "max_cluster_count", "min_cluster_count",
 	"scaling_policy", "auto_suspend", "auto_resume",
 	"resource_monitor", "max_concurrency_level", "statement_queued_timeout_in_seconds",
 	"statement_timeout_in_seconds", "enable_query_acceleration", "query_acceleration_max_scale_factor",
-	"warehouse_type",
 }
 
 var warehouseSchema = map[string]*schema.Schema{
@@ -118,10 +117,11 @@ var warehouseSchema = map[string]*schema.Schema{
 		Description: "Object parameter that specifies the time, in seconds, a SQL statement (query, DDL, DML, etc.) can be queued on a warehouse before it is canceled by the system.",
 	},
 	"max_concurrency_level": {
-		Type:        schema.TypeInt,
-		Optional:    true,
-		Default:     8,
-		Description: "Object parameter that specifies the concurrency level for SQL statements (i.e. queries and DML) executed by a warehouse.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      8,
+		ValidateFunc: validation.IntBetween(1, 10),
+		Description:  "Object parameter that specifies the concurrency level for SQL statements (i.e. queries and DML) executed by a warehouse. Must be between 1 and 10.",
 	},
 	"enable_query_acceleration": {
 		Type:        schema.TypeBool,
@@ -137,11 +137,13 @@ var warehouseSchema = map[string]*schema.Schema{
 		Description:  "Specifies the maximum scale factor for leasing compute resources for query acceleration. The scale factor is used as a multiplier based on warehouse size.",
 	},
 	"warehouse_type": {
-		Type:         schema.TypeString,
-		Optional:     true,
-		Default:      "STANDARD",
-		ValidateFunc: validation.StringInSlice([]string{"STANDARD", "SNOWPARK-OPTIMIZED"}, true),
-		Description:  "Specifies a STANDARD or SNOWPARK-OPTIMIZED warehouse",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "STANDARD",
+		ForceNew:         true,
+		ValidateFunc:     validation.StringInSlice([]string{"STANDARD", "SNOWPARK-OPTIMIZED"}, true),
+		DiffSuppressFunc: diffCaseInsensitive,
+		Description:      "Specifies a STANDARD or SNOWPARK-OPTIMIZED warehouse. Changing the type recreates the warehouse.",
 	},
 	"tag": tagReferenceSchema,
 }
@@ -10,13 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var validWarehousePrivileges = NewPrivilegeSet(
-	privilegeModify,
-	privilegeMonitor,
-	privilegeOperate,
-	privilegeOwnership,
-	privilegeUsage,
-)
+var validWarehousePrivileges = privilegesFor("WAREHOUSE")
 
 var warehouseGrantSchema = map[string]*schema.Schema{
 	"warehouse_name": {
@@ -52,6 +46,10 @@ var warehouseGrantSchema = map[string]*schema.Schema{
 		Description: "When this is set to true, multiple grants of the same type can be created. This will cause Terraform to not revoke grants applied to roles and objects outside Terraform.",
 		Default:     false,
 	},
+	"as_role":        asRoleSchema,
+	"current_grants": currentGrantsSchema,
+	"created_on":     createdOnSchema,
+	"granted_by":     grantedBySchema,
 }
 
 // WarehouseGrant returns a pointer to the resource representing a warehouse grant.
@@ -156,6 +154,7 @@ func UpdateWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
 		meta,
+		d.Get("as_role").(string),
 		builder,
 		grantID.Privilege,
 		rolesToRevoke,
@@ -167,6 +166,8 @@ func UpdateWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
 	// then add
 	if err := createGenericGrantRolesAndShares(
 		meta,
+		d.Get("as_role").(string),
+		d.Get("current_grants").(string),
 		builder,
 		grantID.Privilege,
 		grantID.WithGrantOption,
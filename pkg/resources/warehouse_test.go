@@ -37,6 +37,64 @@ func TestWarehouseCreate(t *testing.T) {
 	})
 }
 
+func TestWarehouseMaxConcurrencyLevel(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                  "tst-terraform-sfwh",
+		"max_concurrency_level": 4,
+	}
+	d := schema.TestResourceDataRaw(t, resources.Warehouse().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE WAREHOUSE "tst-terraform-sfwh" .*MAX_CONCURRENCY_LEVEL=4 `).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery("SHOW WAREHOUSES LIKE 'tst-terraform-sfwh").WillReturnRows(
+			sqlmock.NewRows([]string{"name", "comment", "size"}).AddRow("tst-terraform-sfwh", "", "XSMALL"))
+		mock.ExpectQuery(`SHOW PARAMETERS IN WAREHOUSE "tst-terraform-sfwh"`).WillReturnRows(
+			sqlmock.NewRows([]string{"key", "value", "default", "level", "description", "type"}).
+				AddRow("MAX_CONCURRENCY_LEVEL", 4, 8, "WAREHOUSE", "", "NUMBER"))
+		err := resources.CreateWarehouse(d, db)
+		r.NoError(err)
+	})
+	r.Equal(4, d.Get("max_concurrency_level"))
+
+	validate := resources.Warehouse().Schema["max_concurrency_level"].ValidateFunc
+	for _, v := range []int{1, 10} {
+		_, errs := validate(v, "max_concurrency_level")
+		r.Empty(errs)
+	}
+	for _, v := range []int{0, 11} {
+		_, errs := validate(v, "max_concurrency_level")
+		r.NotEmpty(errs)
+	}
+}
+
+func TestWarehouseType(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":           "tst-terraform-sfwh",
+		"warehouse_type": "snowpark-optimized",
+	}
+	d := schema.TestResourceDataRaw(t, resources.Warehouse().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE WAREHOUSE "tst-terraform-sfwh" .*WAREHOUSE_TYPE='snowpark-optimized'`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery("SHOW WAREHOUSES LIKE 'tst-terraform-sfwh").WillReturnRows(
+			sqlmock.NewRows([]string{"name", "comment", "size", "warehouse_type"}).AddRow("tst-terraform-sfwh", "", "MEDIUM", "SNOWPARK-OPTIMIZED"))
+		mock.ExpectQuery(`SHOW PARAMETERS IN WAREHOUSE "tst-terraform-sfwh"`).WillReturnRows(
+			sqlmock.NewRows([]string{"key", "value", "default", "level", "description", "type"}))
+		err := resources.CreateWarehouse(d, db)
+		r.NoError(err)
+	})
+	r.Equal("SNOWPARK-OPTIMIZED", d.Get("warehouse_type"))
+
+	typeSchema := resources.Warehouse().Schema["warehouse_type"]
+	r.True(typeSchema.ForceNew)
+	r.True(typeSchema.DiffSuppressFunc("warehouse_type", "SNOWPARK-OPTIMIZED", "snowpark-optimized", d))
+	r.False(typeSchema.DiffSuppressFunc("warehouse_type", "STANDARD", "SNOWPARK-OPTIMIZED", d))
+}
+
 func expectReadWarehouse(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{"name", "comment", "size"}).AddRow("tst-terraform-sfwh", "mock comment", "SMALL")
 	mock.ExpectQuery("SHOW WAREHOUSES LIKE 'tst-terraform-sfwh").WillReturnRows(rows)
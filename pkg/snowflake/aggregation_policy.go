@@ -0,0 +1,46 @@
+package snowflake
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// AggregationPolicy is a row of the SHOW AGGREGATION POLICIES output.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/show-aggregation-policies)
+type AggregationPolicy struct {
+	Name         sql.NullString `db:"name"`
+	DatabaseName sql.NullString `db:"database_name"`
+	SchemaName   sql.NullString `db:"schema_name"`
+	Owner        sql.NullString `db:"owner"`
+	Comment      sql.NullString `db:"comment"`
+}
+
+// ListAggregationPolicies returns the aggregation policies in the given schema, optionally filtered by a LIKE pattern.
+func ListAggregationPolicies(databaseName string, schemaName string, pattern string, db *sql.DB) ([]AggregationPolicy, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW AGGREGATION POLICIES")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	stmt.WriteString(fmt.Sprintf(` IN SCHEMA "%v"."%v"`, databaseName, schemaName))
+	rows, err := Query(db, stmt.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbs := []AggregationPolicy{}
+	if err := sqlx.StructScan(rows, &dbs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Println("[DEBUG] no aggregation policies found")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
+	}
+	return dbs, nil
+}
@@ -0,0 +1,78 @@
+package snowflake
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AggregationPolicyAttachmentBuilder abstracts the creation of SQL queries setting an aggregation
+// policy on a table or view and unsetting it.
+type AggregationPolicyAttachmentBuilder struct {
+	objectType   string
+	objectDB     string
+	objectSchema string
+	objectName   string
+	policy       string
+	entityKey    []string
+}
+
+// AggregationPolicyAttachment returns a pointer to a Builder for the aggregation policy of the
+// object of objectType (TABLE, VIEW or MATERIALIZED_VIEW) named name.
+func AggregationPolicyAttachment(objectType, db, schema, name string) *AggregationPolicyAttachmentBuilder {
+	return &AggregationPolicyAttachmentBuilder{
+		objectType:   objectType,
+		objectDB:     db,
+		objectSchema: schema,
+		objectName:   name,
+	}
+}
+
+// WithPolicy sets the fully qualified name of the aggregation policy.
+func (b *AggregationPolicyAttachmentBuilder) WithPolicy(qualifiedName string) *AggregationPolicyAttachmentBuilder {
+	b.policy = qualifiedName
+	return b
+}
+
+// WithEntityKey sets the columns identifying an entity, e.g. a customer, for the aggregation.
+func (b *AggregationPolicyAttachmentBuilder) WithEntityKey(columns []string) *AggregationPolicyAttachmentBuilder {
+	b.entityKey = columns
+	return b
+}
+
+// QualifiedName returns the escaped name of the object the policy is attached to.
+func (b *AggregationPolicyAttachmentBuilder) QualifiedName() string {
+	return fmt.Sprintf(`"%v"."%v"."%v"`, b.objectDB, b.objectSchema, b.objectName)
+}
+
+func (b *AggregationPolicyAttachmentBuilder) alter() string {
+	return fmt.Sprintf(`ALTER %v %v`, strings.ReplaceAll(b.objectType, "_", " "), b.QualifiedName())
+}
+
+// Set returns the SQL query that will set the aggregation policy on the object.
+func (b *AggregationPolicyAttachmentBuilder) Set() string {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`%v SET AGGREGATION POLICY %v`, b.alter(), b.policy))
+	if len(b.entityKey) > 0 {
+		columns := make([]string, 0, len(b.entityKey))
+		for _, c := range b.entityKey {
+			columns = append(columns, fmt.Sprintf(`"%v"`, c))
+		}
+		q.WriteString(fmt.Sprintf(` ENTITY KEY (%v)`, strings.Join(columns, ", ")))
+	}
+	return q.String()
+}
+
+// Unset returns the SQL query that will unset the aggregation policy of the object.
+func (b *AggregationPolicyAttachmentBuilder) Unset() string {
+	return fmt.Sprintf(`%v UNSET AGGREGATION POLICY`, b.alter())
+}
+
+// Show returns the SQL query that will list the policies attached to the object. Materialized views
+// are listed in the VIEW domain.
+func (b *AggregationPolicyAttachmentBuilder) Show() string {
+	domain := b.objectType
+	if domain == "MATERIALIZED_VIEW" {
+		domain = "VIEW"
+	}
+	return PolicyReferences(b.objectDB, b.QualifiedName(), domain)
+}
@@ -0,0 +1,30 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregationPolicyAttachment(t *testing.T) {
+	r := require.New(t)
+	b := AggregationPolicyAttachment("TABLE", "db", "schema", "table").
+		WithPolicy(`"pdb"."pschema"."policy"`)
+
+	r.Equal(`ALTER TABLE "db"."schema"."table" SET AGGREGATION POLICY "pdb"."pschema"."policy"`, b.Set())
+	r.Equal(`ALTER TABLE "db"."schema"."table" UNSET AGGREGATION POLICY`, b.Unset())
+	r.Equal(`SELECT * FROM TABLE("db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"db"."schema"."table"', REF_ENTITY_DOMAIN => 'TABLE'))`, b.Show())
+
+	b.WithEntityKey([]string{"customer_id", "REGION"})
+	r.Equal(`ALTER TABLE "db"."schema"."table" SET AGGREGATION POLICY "pdb"."pschema"."policy" ENTITY KEY ("customer_id", "REGION")`, b.Set())
+}
+
+func TestAggregationPolicyAttachmentMaterializedView(t *testing.T) {
+	r := require.New(t)
+	b := AggregationPolicyAttachment("MATERIALIZED_VIEW", "db", "schema", "mv").
+		WithPolicy(`"pdb"."pschema"."policy"`)
+
+	r.Equal(`ALTER MATERIALIZED VIEW "db"."schema"."mv" SET AGGREGATION POLICY "pdb"."pschema"."policy"`, b.Set())
+	r.Equal(`ALTER MATERIALIZED VIEW "db"."schema"."mv" UNSET AGGREGATION POLICY`, b.Unset())
+	r.Equal(`SELECT * FROM TABLE("db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"db"."schema"."mv"', REF_ENTITY_DOMAIN => 'VIEW'))`, b.Show())
+}
@@ -0,0 +1,28 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAggregationPolicies(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "kind", "owner", "comment", "options", "owner_role_type",
+	}).AddRow("", "test_policy", "test_db", "test_schema", "AGGREGATION_POLICY", "ACCOUNTADMIN", "great comment", "", "ROLE")
+	mock.ExpectQuery(`^SHOW AGGREGATION POLICIES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	policies, err := ListAggregationPolicies("test_db", "test_schema", "test%", mockDB)
+	r.NoError(err)
+	r.Len(policies, 1)
+	r.Equal("test_policy", policies[0].Name.String)
+	r.Equal("ACCOUNTADMIN", policies[0].Owner.String)
+	r.Equal("great comment", policies[0].Comment.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,50 @@
+package snowflake
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Alert is a row of the SHOW ALERTS output.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/show-alerts.html)
+type Alert struct {
+	Name         sql.NullString `db:"name"`
+	DatabaseName sql.NullString `db:"database_name"`
+	SchemaName   sql.NullString `db:"schema_name"`
+	Owner        sql.NullString `db:"owner"`
+	Comment      sql.NullString `db:"comment"`
+	Condition    sql.NullString `db:"condition"`
+	Action       sql.NullString `db:"action"`
+	State        sql.NullString `db:"state"`
+	Schedule     sql.NullString `db:"schedule"`
+}
+
+// ListAlerts returns the alerts in the given schema, optionally filtered by a LIKE pattern.
+func ListAlerts(databaseName string, schemaName string, pattern string, db *sql.DB) ([]Alert, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW ALERTS")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	stmt.WriteString(fmt.Sprintf(` IN SCHEMA "%v"."%v"`, databaseName, schemaName))
+	rows, err := Query(db, stmt.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbs := []Alert{}
+	if err := sqlx.StructScan(rows, &dbs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Println("[DEBUG] no alerts found")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
+	}
+	return dbs, nil
+}
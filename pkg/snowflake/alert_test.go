@@ -0,0 +1,29 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAlerts(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "owner", "comment", "warehouse", "schedule", "state", "condition", "action", "owner_role_type",
+	}).AddRow("", "test_alert", "test_db", "test_schema", "ACCOUNTADMIN", "great comment", "test_wh", "1 minute", "started", "SELECT 1", "SELECT 2", "ROLE")
+	mock.ExpectQuery(`^SHOW ALERTS LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	alerts, err := ListAlerts("test_db", "test_schema", "test%", mockDB)
+	r.NoError(err)
+	r.Len(alerts, 1)
+	r.Equal("test_alert", alerts[0].Name.String)
+	r.Equal("started", alerts[0].State.String)
+	r.Equal("1 minute", alerts[0].Schedule.String)
+	r.Equal("SELECT 1", alerts[0].Condition.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,164 @@
+package snowflake
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// AllGrantBuilder abstracts the creation of AllGrantExecutables, which grant a privilege on all
+// existing objects of a type in a database or schema (GRANT ... ON ALL <type>S IN ...). Snowflake
+// expands such a grant into one grant per object when it is executed and does not record it, so it
+// is read back from the grants on each of the objects it covers, see ShowAllGrantObjects.
+type AllGrantBuilder struct {
+	name           string
+	qualifiedName  string
+	allGrantType   futureGrantType
+	allGrantTarget futureGrantTarget
+}
+
+// Name returns the object name for this AllGrantBuilder.
+func (agb *AllGrantBuilder) Name() string {
+	return agb.name
+}
+
+func (agb *AllGrantBuilder) GrantType() string {
+	return string(agb.allGrantType)
+}
+
+// AllSchemaGrant returns a pointer to an AllGrantBuilder for all schemas in a database.
+func AllSchemaGrant(db string) GrantBuilder {
+	return &AllGrantBuilder{
+		name:           db,
+		qualifiedName:  fmt.Sprintf(`"%v"`, db),
+		allGrantType:   futureSchemaType,
+		allGrantTarget: futureDatabaseTarget,
+	}
+}
+
+// AllStreamGrant returns a pointer to an AllGrantBuilder for all streams in a schema, or in a
+// database when schema is empty.
+func AllStreamGrant(db, schema string) GrantBuilder {
+	name, qualifiedName, allTarget := getNameAndQualifiedName(db, schema)
+	return &AllGrantBuilder{
+		name:           name,
+		qualifiedName:  qualifiedName,
+		allGrantType:   futureStreamType,
+		allGrantTarget: allTarget,
+	}
+}
+
+// Show returns the SQL that will list the objects the grant covers, e.g. SHOW STREAMS IN SCHEMA.
+// Grants on all objects are not recorded as such, and showing the grants on the database or schema
+// itself would confuse them with the grants on the container.
+func (agb *AllGrantBuilder) Show() string {
+	return fmt.Sprintf(`SHOW %vS IN %v %v`, agb.allGrantType, agb.allGrantTarget, agb.qualifiedName)
+}
+
+// AllGrantExecutable abstracts the creation of SQL queries to grant on all existing objects of a type.
+type AllGrantExecutable struct {
+	grantName      string
+	granteeName    string
+	allGrantType   futureGrantType
+	allGrantTarget futureGrantTarget
+}
+
+// Role returns a pointer to an AllGrantExecutable for a role.
+func (agb *AllGrantBuilder) Role(n string) GrantExecutable {
+	return &AllGrantExecutable{
+		granteeName:    n,
+		grantName:      agb.qualifiedName,
+		allGrantType:   agb.allGrantType,
+		allGrantTarget: agb.allGrantTarget,
+	}
+}
+
+// Share is not implemented because grants on all objects cannot be made to shares.
+func (agb *AllGrantBuilder) Share(n string) GrantExecutable {
+	return nil
+}
+
+// Grant returns the SQL that will grant privileges on all existing objects to the grantee.
+func (age *AllGrantExecutable) Grant(p string, w bool) string {
+	var template string
+	if w {
+		template = `GRANT %v ON ALL %vS IN %v %v TO ROLE "%v" WITH GRANT OPTION`
+	} else {
+		template = `GRANT %v ON ALL %vS IN %v %v TO ROLE "%v"`
+	}
+	return fmt.Sprintf(template,
+		p, age.allGrantType, age.allGrantTarget, age.grantName, age.granteeName)
+}
+
+// Revoke returns the SQL that will revoke privileges on all existing objects from the grantee.
+func (age *AllGrantExecutable) Revoke(p string) []string {
+	return []string{
+		fmt.Sprintf(`REVOKE %v ON ALL %vS IN %v %v FROM ROLE "%v"`,
+			p, age.allGrantType, age.allGrantTarget, age.grantName, age.granteeName),
+	}
+}
+
+// Show returns the SQL that will show the grants to the role, which include those on each object
+// the grant covers.
+func (age *AllGrantExecutable) Show() string {
+	return fmt.Sprintf(`SHOW GRANTS TO ROLE "%v"`, age.granteeName)
+}
+
+type allGrantObject struct {
+	Name         sql.NullString `db:"name"`
+	DatabaseName sql.NullString `db:"database_name"`
+	SchemaName   sql.NullString `db:"schema_name"`
+}
+
+// ShowAllGrantObjects returns the fully qualified names of the objects a grant on all objects
+// currently covers, normalized so that they compare equal to the name column of SHOW GRANTS.
+func ShowAllGrantObjects(db *sql.DB, agb *AllGrantBuilder) ([]string, error) {
+	rows, err := Query(db, agb.Show())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	names := []string{}
+	for rows.Next() {
+		object := &allGrantObject{}
+		if err := rows.StructScan(object); err != nil {
+			return nil, err
+		}
+		// the information schema is read only and not covered by grants on all schemas
+		if agb.allGrantType == futureSchemaType && object.Name.String == "INFORMATION_SCHEMA" {
+			continue
+		}
+		var parts []string
+		for _, p := range []sql.NullString{object.DatabaseName, object.SchemaName, object.Name} {
+			if p.String != "" {
+				parts = append(parts, `"`+strings.ReplaceAll(p.String, `"`, `""`)+`"`)
+			}
+		}
+		names = append(names, normalizeGrantName(strings.Join(parts, ".")))
+	}
+	return names, rows.Err()
+}
+
+// ShowAllGrantPrivileges returns the privileges granted to role directly on each of objects, as
+// returned by ShowAllGrantObjects for agb. Objects on which role holds nothing are left out.
+func ShowAllGrantPrivileges(db *sql.DB, agb *AllGrantBuilder, role string, objects []string) (map[string][]string, error) {
+	grants, err := queryGrants(db, agb.Role(role).Show())
+	if err != nil {
+		return nil, err
+	}
+	covered := map[string]bool{}
+	for _, o := range objects {
+		covered[o] = true
+	}
+	grantedOn := normalizeGrantedOn(string(agb.allGrantType))
+	privileges := map[string][]string{}
+	for _, grant := range grants {
+		name := normalizeGrantName(grant.Name.String)
+		if normalizeGrantedOn(grant.GrantedOn.String) != grantedOn || !covered[name] {
+			continue
+		}
+		privileges[name] = append(privileges[name], grant.Privilege.String)
+	}
+	return privileges, nil
+}
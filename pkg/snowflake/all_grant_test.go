@@ -0,0 +1,47 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllSchemaGrant(t *testing.T) {
+	r := require.New(t)
+	asg := snowflake.AllSchemaGrant("test_db")
+	r.Equal("test_db", asg.Name())
+	r.Equal("SCHEMA", asg.GrantType())
+	r.Nil(asg.Share("share"))
+	r.Equal(`SHOW SCHEMAS IN DATABASE "test_db"`, asg.Show())
+	r.Equal(`SHOW GRANTS TO ROLE "bob"`, asg.Role("bob").Show())
+
+	s := asg.Role("bob").Grant("MONITOR", false)
+	r.Equal(`GRANT MONITOR ON ALL SCHEMAS IN DATABASE "test_db" TO ROLE "bob"`, s)
+
+	s = asg.Role("bob").Grant("MONITOR", true)
+	r.Equal(`GRANT MONITOR ON ALL SCHEMAS IN DATABASE "test_db" TO ROLE "bob" WITH GRANT OPTION`, s)
+
+	revoke := asg.Role("bob").Revoke("MONITOR")
+	r.Equal([]string{`REVOKE MONITOR ON ALL SCHEMAS IN DATABASE "test_db" FROM ROLE "bob"`}, revoke)
+}
+
+func TestAllStreamGrant(t *testing.T) {
+	r := require.New(t)
+	asg := snowflake.AllStreamGrant("test_db", "PUBLIC")
+	r.Equal("PUBLIC", asg.Name())
+	r.Equal("STREAM", asg.GrantType())
+	r.Equal(`SHOW STREAMS IN SCHEMA "test_db"."PUBLIC"`, asg.Show())
+
+	s := asg.Role("bob").Grant("SELECT", false)
+	r.Equal(`GRANT SELECT ON ALL STREAMS IN SCHEMA "test_db"."PUBLIC" TO ROLE "bob"`, s)
+
+	revoke := asg.Role("bob").Revoke("SELECT")
+	r.Equal([]string{`REVOKE SELECT ON ALL STREAMS IN SCHEMA "test_db"."PUBLIC" FROM ROLE "bob"`}, revoke)
+
+	asg = snowflake.AllStreamGrant("test_db", "")
+	r.Equal("test_db", asg.Name())
+
+	s = asg.Role("bob").Grant("SELECT", true)
+	r.Equal(`GRANT SELECT ON ALL STREAMS IN DATABASE "test_db" TO ROLE "bob" WITH GRANT OPTION`, s)
+}
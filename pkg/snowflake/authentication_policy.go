@@ -0,0 +1,85 @@
+package snowflake
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// AuthenticationPolicy is a row of the SHOW AUTHENTICATION POLICIES output.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/show-authentication-policies)
+type AuthenticationPolicy struct {
+	Name         sql.NullString `db:"name"`
+	DatabaseName sql.NullString `db:"database_name"`
+	SchemaName   sql.NullString `db:"schema_name"`
+	Comment      sql.NullString `db:"comment"`
+}
+
+// ListAuthenticationPolicies returns the authentication policies in the given schema, optionally filtered by a LIKE pattern.
+func ListAuthenticationPolicies(databaseName string, schemaName string, pattern string, db *sql.DB) ([]AuthenticationPolicy, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW AUTHENTICATION POLICIES")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	stmt.WriteString(fmt.Sprintf(` IN SCHEMA "%v"."%v"`, databaseName, schemaName))
+	rows, err := Query(db, stmt.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbs := []AuthenticationPolicy{}
+	if err := sqlx.StructScan(rows, &dbs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Println("[DEBUG] no authentication policies found")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
+	}
+	return dbs, nil
+}
+
+// AuthenticationPolicyDescription holds the properties of an authentication policy that SHOW
+// AUTHENTICATION POLICIES does not report.
+type AuthenticationPolicyDescription struct {
+	AuthenticationMethods []string
+	MfaEnrollment         string
+}
+
+// DescribeAuthenticationPolicy returns the AUTHENTICATION_METHODS and MFA_ENROLLMENT properties of
+// the given authentication policy.
+func DescribeAuthenticationPolicy(databaseName string, schemaName string, name string, db *sql.DB) (*AuthenticationPolicyDescription, error) {
+	stmt := fmt.Sprintf(`DESCRIBE AUTHENTICATION POLICY "%v"."%v"."%v"`, databaseName, schemaName, name)
+	rows, err := Query(db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	description := &AuthenticationPolicyDescription{AuthenticationMethods: []string{}}
+	for rows.Next() {
+		property := struct {
+			Property sql.NullString `db:"property"`
+			Value    sql.NullString `db:"value"`
+		}{}
+		if err := rows.StructScan(&property); err != nil {
+			return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+		}
+		switch property.Property.String {
+		case "AUTHENTICATION_METHODS":
+			for _, method := range strings.Split(strings.Trim(property.Value.String, "[]"), ",") {
+				if method = strings.Trim(strings.TrimSpace(method), "'"); method != "" {
+					description.AuthenticationMethods = append(description.AuthenticationMethods, method)
+				}
+			}
+		case "MFA_ENROLLMENT":
+			description.MfaEnrollment = property.Value.String
+		}
+	}
+	return description, rows.Err()
+}
@@ -0,0 +1,48 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAuthenticationPolicies(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "comment", "database_name", "schema_name", "owner", "owner_role_type", "options",
+	}).AddRow("", "test_policy", "great comment", "test_db", "test_schema", "ACCOUNTADMIN", "ROLE", "")
+	mock.ExpectQuery(`^SHOW AUTHENTICATION POLICIES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	policies, err := ListAuthenticationPolicies("test_db", "test_schema", "test%", mockDB)
+	r.NoError(err)
+	r.Len(policies, 1)
+	r.Equal("test_policy", policies[0].Name.String)
+	r.Equal("test_db", policies[0].DatabaseName.String)
+	r.Equal("great comment", policies[0].Comment.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDescribeAuthenticationPolicy(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{"property", "value", "default", "description"}).
+		AddRow("NAME", "test_policy", "", "").
+		AddRow("AUTHENTICATION_METHODS", "[PASSWORD, SAML]", "[ALL]", "").
+		AddRow("MFA_ENROLLMENT", "REQUIRED", "OPTIONAL", "").
+		AddRow("COMMENT", "great comment", "", "")
+	mock.ExpectQuery(`^DESCRIBE AUTHENTICATION POLICY "test_db"."test_schema"."test_policy"$`).WillReturnRows(rows)
+
+	description, err := DescribeAuthenticationPolicy("test_db", "test_schema", "test_policy", mockDB)
+	r.NoError(err)
+	r.Equal([]string{"PASSWORD", "SAML"}, description.AuthenticationMethods)
+	r.Equal("REQUIRED", description.MfaEnrollment)
+	r.NoError(mock.ExpectationsWereMet())
+}
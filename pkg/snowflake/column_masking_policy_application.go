@@ -0,0 +1,52 @@
+package snowflake
+
+import (
+	"fmt"
+)
+
+// ColumnMaskingPolicyApplicationBuilder abstracts the creation of SQL queries setting a masking policy
+// on a table column and unsetting it.
+type ColumnMaskingPolicyApplicationBuilder struct {
+	tableDB     string
+	tableSchema string
+	tableName   string
+	column      string
+	policy      string
+}
+
+// ColumnMaskingPolicyApplication returns a pointer to a Builder for the masking policy of the column
+// of the table named name.
+func ColumnMaskingPolicyApplication(db, schema, name, column string) *ColumnMaskingPolicyApplicationBuilder {
+	return &ColumnMaskingPolicyApplicationBuilder{
+		tableDB:     db,
+		tableSchema: schema,
+		tableName:   name,
+		column:      column,
+	}
+}
+
+// WithPolicy sets the fully qualified name of the masking policy.
+func (b *ColumnMaskingPolicyApplicationBuilder) WithPolicy(qualifiedName string) *ColumnMaskingPolicyApplicationBuilder {
+	b.policy = qualifiedName
+	return b
+}
+
+// QualifiedName returns the escaped name of the table.
+func (b *ColumnMaskingPolicyApplicationBuilder) QualifiedName() string {
+	return fmt.Sprintf(`"%v"."%v"."%v"`, b.tableDB, b.tableSchema, b.tableName)
+}
+
+// Set returns the SQL query that will set the masking policy on the column.
+func (b *ColumnMaskingPolicyApplicationBuilder) Set() string {
+	return fmt.Sprintf(`ALTER TABLE %v ALTER COLUMN "%v" SET MASKING POLICY %v`, b.QualifiedName(), b.column, b.policy)
+}
+
+// Unset returns the SQL query that will unset the masking policy of the column.
+func (b *ColumnMaskingPolicyApplicationBuilder) Unset() string {
+	return fmt.Sprintf(`ALTER TABLE %v ALTER COLUMN "%v" UNSET MASKING POLICY`, b.QualifiedName(), b.column)
+}
+
+// Show returns the SQL query that will list the policies attached to the table.
+func (b *ColumnMaskingPolicyApplicationBuilder) Show() string {
+	return PolicyReferences(b.tableDB, b.QualifiedName(), "TABLE")
+}
@@ -0,0 +1,17 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnMaskingPolicyApplication(t *testing.T) {
+	r := require.New(t)
+	b := ColumnMaskingPolicyApplication("db", "schema", "table", "email").
+		WithPolicy(`"mp_db"."mp_schema"."mp_name"`)
+
+	r.Equal(`ALTER TABLE "db"."schema"."table" ALTER COLUMN "email" SET MASKING POLICY "mp_db"."mp_schema"."mp_name"`, b.Set())
+	r.Equal(`ALTER TABLE "db"."schema"."table" ALTER COLUMN "email" UNSET MASKING POLICY`, b.Unset())
+	r.Equal(`SELECT * FROM TABLE("db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"db"."schema"."table"', REF_ENTITY_DOMAIN => 'TABLE'))`, b.Show())
+}
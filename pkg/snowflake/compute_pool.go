@@ -0,0 +1,50 @@
+package snowflake
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ComputePool is a row of the SHOW COMPUTE POOLS output.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/show-compute-pools)
+type ComputePool struct {
+	Name            sql.NullString `db:"name"`
+	State           sql.NullString `db:"state"`
+	MinNodes        sql.NullInt64  `db:"min_nodes"`
+	MaxNodes        sql.NullInt64  `db:"max_nodes"`
+	InstanceFamily  sql.NullString `db:"instance_family"`
+	NumServices     sql.NullInt64  `db:"num_services"`
+	AutoResume      sql.NullBool   `db:"auto_resume"`
+	AutoSuspendSecs sql.NullInt64  `db:"auto_suspend_secs"`
+	Owner           sql.NullString `db:"owner"`
+	Comment         sql.NullString `db:"comment"`
+}
+
+// ListComputePools returns the compute pools in the account, optionally filtered by a LIKE pattern.
+func ListComputePools(pattern string, db *sql.DB) ([]ComputePool, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW COMPUTE POOLS")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	rows, err := Query(db, stmt.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbs := []ComputePool{}
+	if err := sqlx.StructScan(rows, &dbs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Println("[DEBUG] no compute pools found")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
+	}
+	return dbs, nil
+}
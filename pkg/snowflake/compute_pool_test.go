@@ -0,0 +1,31 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListComputePools(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"name", "state", "min_nodes", "max_nodes", "instance_family", "num_services", "num_jobs", "auto_suspend_secs", "auto_resume", "owner", "comment",
+	}).AddRow("TEST_POOL", "ACTIVE", 1, 3, "CPU_X64_XS", 2, 0, 3600, true, "ACCOUNTADMIN", "great comment")
+	mock.ExpectQuery(`^SHOW COMPUTE POOLS LIKE 'test%'$`).WillReturnRows(rows)
+
+	pools, err := ListComputePools("test%", mockDB)
+	r.NoError(err)
+	r.Len(pools, 1)
+	r.Equal("TEST_POOL", pools[0].Name.String)
+	r.Equal("ACTIVE", pools[0].State.String)
+	r.Equal(int64(3), pools[0].MaxNodes.Int64)
+	r.Equal("CPU_X64_XS", pools[0].InstanceFamily.String)
+	r.True(pools[0].AutoResume.Bool)
+	r.Equal(int64(3600), pools[0].AutoSuspendSecs.Int64)
+	r.NoError(mock.ExpectationsWereMet())
+}
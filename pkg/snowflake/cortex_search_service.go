@@ -0,0 +1,49 @@
+package snowflake
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// CortexSearchService is a row of the SHOW CORTEX SEARCH SERVICES output.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/show-cortex-search)
+type CortexSearchService struct {
+	Name           sql.NullString `db:"name"`
+	DatabaseName   sql.NullString `db:"database_name"`
+	SchemaName     sql.NullString `db:"schema_name"`
+	Warehouse      sql.NullString `db:"warehouse"`
+	TargetLag      sql.NullString `db:"target_lag"`
+	SearchColumn   sql.NullString `db:"search_column"`
+	EmbeddingModel sql.NullString `db:"embedding_model"`
+	Comment        sql.NullString `db:"comment"`
+}
+
+// ListCortexSearchServices returns the cortex search services in the given schema, optionally filtered by a LIKE pattern.
+func ListCortexSearchServices(databaseName string, schemaName string, pattern string, db *sql.DB) ([]CortexSearchService, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW CORTEX SEARCH SERVICES")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	stmt.WriteString(fmt.Sprintf(` IN SCHEMA "%v"."%v"`, databaseName, schemaName))
+	rows, err := Query(db, stmt.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbs := []CortexSearchService{}
+	if err := sqlx.StructScan(rows, &dbs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Println("[DEBUG] no cortex search services found")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
+	}
+	return dbs, nil
+}
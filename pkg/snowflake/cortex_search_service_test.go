@@ -0,0 +1,29 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCortexSearchServices(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "warehouse", "target_lag", "search_column", "embedding_model", "comment",
+	}).AddRow("", "test_service", "test_db", "test_schema", "test_wh", "1 hour", "TRANSCRIPT", "snowflake-arctic-embed-m-v1.5", "great comment")
+	mock.ExpectQuery(`^SHOW CORTEX SEARCH SERVICES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	services, err := ListCortexSearchServices("test_db", "test_schema", "test%", mockDB)
+	r.NoError(err)
+	r.Len(services, 1)
+	r.Equal("test_service", services[0].Name.String)
+	r.Equal("1 hour", services[0].TargetLag.String)
+	r.Equal("TRANSCRIPT", services[0].SearchColumn.String)
+	r.Equal("snowflake-arctic-embed-m-v1.5", services[0].EmbeddingModel.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,124 @@
+package snowflake
+
+import (
+	"database/sql"
+	"regexp"
+	"strings"
+)
+
+// EffectiveGrant is the result of resolving whether a role holds a privilege on an object, either
+// directly or through the roles granted to it.
+type EffectiveGrant struct {
+	Granted bool
+	// Privilege is the privilege that was found. It is OWNERSHIP when the privilege is implied by
+	// ownership of the object.
+	Privilege string
+	// Path lists the roles from the queried role to the role holding the privilege. A path of
+	// length one means the privilege is granted to the queried role directly.
+	Path []string
+}
+
+// ResolveEffectiveGrant walks the role hierarchy breadth-first from role, running SHOW GRANTS TO
+// ROLE for each role reached, until it finds privilege (or OWNERSHIP) on the object. Every role
+// implicitly inherits PUBLIC. Database roles are not followed.
+func ResolveEffectiveGrant(db *sql.DB, role, objectType, objectName, privilege string) (*EffectiveGrant, error) {
+	objectType = normalizeGrantedOn(objectType)
+	objectName = normalizeGrantName(objectName)
+	privilege = strings.ToUpper(privilege)
+
+	parents := map[string]string{role: ""}
+	queue := []string{role}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		grants, err := ShowGrantsTo(db, "ROLE", current)
+		if err != nil {
+			return nil, err
+		}
+
+		inherited := []string{}
+		for _, grant := range grants {
+			grantedOn := normalizeGrantedOn(grant.GrantedOn.String)
+			if grantedOn == "ROLE" && grant.Privilege.String == "USAGE" {
+				inherited = append(inherited, grant.Name.String)
+				continue
+			}
+			if grantedOn != objectType || normalizeGrantName(grant.Name.String) != objectName {
+				continue
+			}
+			if p := grant.Privilege.String; p == privilege || p == "OWNERSHIP" {
+				return &EffectiveGrant{Granted: true, Privilege: p, Path: rolePath(parents, current)}, nil
+			}
+		}
+		if current == role && role != "PUBLIC" {
+			inherited = append(inherited, "PUBLIC")
+		}
+
+		for _, r := range inherited {
+			if _, seen := parents[r]; seen {
+				continue
+			}
+			parents[r] = current
+			queue = append(queue, r)
+		}
+	}
+	return &EffectiveGrant{Granted: false, Path: []string{}}, nil
+}
+
+// rolePath returns the roles from the root of parents down to role.
+func rolePath(parents map[string]string, role string) []string {
+	path := []string{}
+	for r := role; r != ""; r = parents[r] {
+		path = append([]string{r}, path...)
+	}
+	return path
+}
+
+// normalizeGrantedOn makes object types given as e.g. "materialized view" comparable to the
+// granted_on column of SHOW GRANTS, which uses MATERIALIZED_VIEW.
+func normalizeGrantedOn(objectType string) string {
+	return strings.ReplaceAll(strings.ToUpper(strings.TrimSpace(objectType)), " ", "_")
+}
+
+// normalizeGrantName makes fully qualified names comparable regardless of quoting. Unquoted parts
+// are upper-cased, as Snowflake resolves them; quoted parts keep their case and are only written
+// unquoted when they name the same object unquoted, so DB.PUBLIC."MYVIEW" and DB.PUBLIC.myview
+// compare equal but DB.PUBLIC."MyView" and DB.PUBLIC.MYVIEW do not.
+func normalizeGrantName(name string) string {
+	var parts []string
+	var part strings.Builder
+	quoted, wasQuoted := false, false
+	flush := func() {
+		p := part.String()
+		if wasQuoted && !unquotedIdentifier.MatchString(p) {
+			p = `"` + strings.ReplaceAll(p, `"`, `""`) + `"`
+		}
+		parts = append(parts, p)
+		part.Reset()
+		wasQuoted = false
+	}
+	runes := []rune(name)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case quoted && c == '"' && i+1 < len(runes) && runes[i+1] == '"':
+			part.WriteRune('"')
+			i++
+		case c == '"':
+			quoted = !quoted
+			wasQuoted = true
+		case quoted:
+			part.WriteRune(c)
+		case c == '.':
+			flush()
+		default:
+			part.WriteString(strings.ToUpper(string(c)))
+		}
+	}
+	flush()
+	return strings.Join(parts, ".")
+}
+
+// unquotedIdentifier matches the identifiers Snowflake resolves the same with or without quotes.
+var unquotedIdentifier = regexp.MustCompile(`^[A-Z_][A-Z0-9_$]*$`)
@@ -0,0 +1,96 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func grantsToRoleRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by"})
+}
+
+func TestResolveEffectiveGrantInherited(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	mock.ExpectQuery(`^SHOW GRANTS TO ROLE "ANALYST"$`).WillReturnRows(grantsToRoleRows().
+		AddRow("", "USAGE", "DATABASE", "DB", "ROLE", "ANALYST", "false", "SYSADMIN").
+		AddRow("", "USAGE", "ROLE", "READER", "ROLE", "ANALYST", "false", "SECURITYADMIN"))
+	mock.ExpectQuery(`^SHOW GRANTS TO ROLE "READER"$`).WillReturnRows(grantsToRoleRows().
+		AddRow("", "USAGE", "ROLE", "ANALYST", "ROLE", "READER", "false", "SECURITYADMIN").
+		AddRow("", "USAGE", "ROLE", "VIEW_READER", "ROLE", "READER", "false", "SECURITYADMIN"))
+	mock.ExpectQuery(`^SHOW GRANTS TO ROLE "PUBLIC"$`).WillReturnRows(grantsToRoleRows())
+	mock.ExpectQuery(`^SHOW GRANTS TO ROLE "VIEW_READER"$`).WillReturnRows(grantsToRoleRows().
+		AddRow("", "SELECT", "VIEW", `DB.PUBLIC."MyView"`, "ROLE", "VIEW_READER", "false", "SYSADMIN"))
+
+	grant, err := ResolveEffectiveGrant(mockDB, "ANALYST", "view", `"DB"."PUBLIC"."MyView"`, "select")
+	r.NoError(err)
+	r.True(grant.Granted)
+	r.Equal("SELECT", grant.Privilege)
+	r.Equal([]string{"ANALYST", "READER", "VIEW_READER"}, grant.Path)
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestResolveEffectiveGrantOwnership(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	mock.ExpectQuery(`^SHOW GRANTS TO ROLE "SYSADMIN"$`).WillReturnRows(grantsToRoleRows().
+		AddRow("", "OWNERSHIP", "MATERIALIZED_VIEW", "DB.PUBLIC.MV", "ROLE", "SYSADMIN", "true", "SYSADMIN"))
+
+	grant, err := ResolveEffectiveGrant(mockDB, "SYSADMIN", "materialized view", "DB.PUBLIC.MV", "SELECT")
+	r.NoError(err)
+	r.True(grant.Granted)
+	r.Equal("OWNERSHIP", grant.Privilege)
+	r.Equal([]string{"SYSADMIN"}, grant.Path)
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestResolveEffectiveGrantNotGranted(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	mock.ExpectQuery(`^SHOW GRANTS TO ROLE "ANALYST"$`).WillReturnRows(grantsToRoleRows().
+		AddRow("", "SELECT", "VIEW", "DB.PUBLIC.OTHER_VIEW", "ROLE", "ANALYST", "false", "SYSADMIN"))
+	mock.ExpectQuery(`^SHOW GRANTS TO ROLE "PUBLIC"$`).WillReturnRows(grantsToRoleRows())
+
+	grant, err := ResolveEffectiveGrant(mockDB, "ANALYST", "VIEW", "DB.PUBLIC.MY_VIEW", "SELECT")
+	r.NoError(err)
+	r.False(grant.Granted)
+	r.Empty(grant.Path)
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestResolveEffectiveGrantQuotedNameIsCaseSensitive(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	mock.ExpectQuery(`^SHOW GRANTS TO ROLE "ANALYST"$`).WillReturnRows(grantsToRoleRows().
+		AddRow("", "SELECT", "VIEW", `DB.PUBLIC."MyView"`, "ROLE", "ANALYST", "false", "SYSADMIN"))
+	mock.ExpectQuery(`^SHOW GRANTS TO ROLE "PUBLIC"$`).WillReturnRows(grantsToRoleRows())
+
+	grant, err := ResolveEffectiveGrant(mockDB, "ANALYST", "VIEW", "DB.PUBLIC.MYVIEW", "SELECT")
+	r.NoError(err)
+	r.False(grant.Granted)
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestNormalizeGrantName(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("DB.PUBLIC.MYVIEW", normalizeGrantName("db.public.myView"))
+	r.Equal("DB.PUBLIC.MYVIEW", normalizeGrantName(`"DB"."PUBLIC"."MYVIEW"`))
+	r.Equal(`DB.PUBLIC."MyView"`, normalizeGrantName(`"DB".public."MyView"`))
+	r.Equal(`DB."my.schema"."a""b"`, normalizeGrantName(`DB."my.schema"."a""b"`))
+	r.NotEqual(normalizeGrantName(`DB.PUBLIC."MyView"`), normalizeGrantName("DB.PUBLIC.MYVIEW"))
+}
@@ -0,0 +1,48 @@
+package snowflake
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// EventTable is a row of the SHOW EVENT TABLES output.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/show-event-tables)
+type EventTable struct {
+	Name         sql.NullString `db:"name"`
+	DatabaseName sql.NullString `db:"database_name"`
+	SchemaName   sql.NullString `db:"schema_name"`
+	Owner        sql.NullString `db:"owner"`
+	Comment      sql.NullString `db:"comment"`
+	Rows         sql.NullInt64  `db:"rows"`
+	Bytes        sql.NullInt64  `db:"bytes"`
+}
+
+// ListEventTables returns the event tables in the given schema, optionally filtered by a LIKE pattern.
+func ListEventTables(databaseName string, schemaName string, pattern string, db *sql.DB) ([]EventTable, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW EVENT TABLES")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	stmt.WriteString(fmt.Sprintf(` IN SCHEMA "%v"."%v"`, databaseName, schemaName))
+	rows, err := Query(db, stmt.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbs := []EventTable{}
+	if err := sqlx.StructScan(rows, &dbs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Println("[DEBUG] no event tables found")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
+	}
+	return dbs, nil
+}
@@ -0,0 +1,29 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListEventTables(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "owner", "comment", "rows", "bytes",
+	}).AddRow("", "test_events", "test_db", "test_schema", "ACCOUNTADMIN", "great comment", 10, 1024)
+	mock.ExpectQuery(`^SHOW EVENT TABLES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	tables, err := ListEventTables("test_db", "test_schema", "test%", mockDB)
+	r.NoError(err)
+	r.Len(tables, 1)
+	r.Equal("test_events", tables[0].Name.String)
+	r.Equal("ACCOUNTADMIN", tables[0].Owner.String)
+	r.Equal(int64(10), tables[0].Rows.Int64)
+	r.Equal(int64(1024), tables[0].Bytes.Int64)
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -1,7 +1,10 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
+	"database/sql/driver"
+	"fmt"
 	"log"
 
 	"github.com/jmoiron/sqlx"
@@ -25,12 +28,78 @@ func ExecMulti(db *sql.DB, queries []string) error {
 	for _, query := range queries {
 		_, err = tx.Exec(query)
 		if err != nil {
-			return tx.Rollback()
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				log.Printf("[DEBUG] unable to roll back after %v: %v", query, rollbackErr)
+			}
+			return err
 		}
 	}
 	return tx.Commit()
 }
 
+// ExecAsRole runs queries on a single connection of the pool after switching it to role with
+// USE ROLE, reusing the credentials the db was opened with. The previous role of the connection is
+// restored afterwards so that the rest of the provider keeps running with its default role; if that
+// fails the connection is discarded instead of being returned to the pool.
+func ExecAsRole(db *sql.DB, role string, queries []string) error {
+	log.Print("[DEBUG] exec stmts as role ", role, " ", queries)
+	return withRole(db, role, func(ctx context.Context, conn *sql.Conn) error {
+		for _, query := range queries {
+			if _, err := conn.ExecContext(ctx, query); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ExecEachAsRole is like ExecAsRole but keeps running the remaining queries when one fails. It
+// returns the errors of the failed queries, or a single error if role could not be switched to.
+func ExecEachAsRole(db *sql.DB, role string, queries []string) []error {
+	log.Print("[DEBUG] exec each stmt as role ", role, " ", queries)
+	var errs []error
+	if err := withRole(db, role, func(ctx context.Context, conn *sql.Conn) error {
+		for _, query := range queries {
+			if _, err := conn.ExecContext(ctx, query); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return nil
+	}); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// withRole calls fn with a connection switched to role, see ExecAsRole.
+func withRole(db *sql.DB, role string, fn func(context.Context, *sql.Conn) error) (err error) {
+	ctx := context.Background()
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var previousRole string
+	if err := conn.QueryRowContext(ctx, "SELECT CURRENT_ROLE()").Scan(&previousRole); err != nil {
+		return err
+	}
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf(`USE ROLE "%v"`, role)); err != nil {
+		return err
+	}
+	defer func() {
+		if _, restoreErr := conn.ExecContext(ctx, fmt.Sprintf(`USE ROLE "%v"`, previousRole)); restoreErr != nil {
+			_ = conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+			if err == nil {
+				err = fmt.Errorf("unable to restore role %v: %w", previousRole, restoreErr)
+			}
+		}
+	}()
+
+	return fn(ctx, conn)
+}
+
 // QueryRow will run stmt against the db and return the row. We use
 // [DB.Unsafe](https://godoc.org/github.com/jmoiron/sqlx#DB.Unsafe) so that we can scan to structs
 // without worrying about newly introduced columns.
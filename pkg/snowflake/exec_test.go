@@ -0,0 +1,76 @@
+package snowflake
+
+import (
+	"errors"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecAsRole(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	mock.ExpectQuery(`^SELECT CURRENT_ROLE\(\)$`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ROLE()"}).AddRow("SYSADMIN"))
+	mock.ExpectExec(`^USE ROLE "SECURITYADMIN"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`^GRANT USAGE ON DATABASE "test_db" TO ROLE "test_role"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`^USE ROLE "SYSADMIN"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	r.NoError(ExecAsRole(mockDB, "SECURITYADMIN", []string{`GRANT USAGE ON DATABASE "test_db" TO ROLE "test_role"`}))
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestExecAsRoleRestoresRoleOnError(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	mock.ExpectQuery(`^SELECT CURRENT_ROLE\(\)$`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ROLE()"}).AddRow("SYSADMIN"))
+	mock.ExpectExec(`^USE ROLE "SECURITYADMIN"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`^GRANT USAGE ON DATABASE "test_db" TO ROLE "test_role"$`).WillReturnError(errors.New("insufficient privileges"))
+	mock.ExpectExec(`^USE ROLE "SYSADMIN"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = ExecAsRole(mockDB, "SECURITYADMIN", []string{`GRANT USAGE ON DATABASE "test_db" TO ROLE "test_role"`})
+	r.EqualError(err, "insufficient privileges")
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestExecMultiReturnsStatementError(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`^SELECT 1$`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`^SELECT 2$`).WillReturnError(errors.New("boom"))
+	mock.ExpectRollback()
+
+	r.EqualError(ExecMulti(mockDB, []string{"SELECT 1", "SELECT 2", "SELECT 3"}), "boom")
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestExecEachAsRoleRunsRemainingQueries(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	mock.ExpectQuery(`^SELECT CURRENT_ROLE\(\)$`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ROLE()"}).AddRow("SYSADMIN"))
+	mock.ExpectExec(`^USE ROLE "SECURITYADMIN"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`^REVOKE USAGE ON DATABASE "test_db" FROM ROLE "dropped_role"$`).WillReturnError(errors.New("role does not exist"))
+	mock.ExpectExec(`^REVOKE USAGE ON DATABASE "test_db" FROM ROLE "test_role"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`^USE ROLE "SYSADMIN"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	errs := ExecEachAsRole(mockDB, "SECURITYADMIN", []string{
+		`REVOKE USAGE ON DATABASE "test_db" FROM ROLE "dropped_role"`,
+		`REVOKE USAGE ON DATABASE "test_db" FROM ROLE "test_role"`,
+	})
+	r.Len(errs, 1)
+	r.EqualError(errs[0], "role does not exist")
+	r.NoError(mock.ExpectationsWereMet())
+}
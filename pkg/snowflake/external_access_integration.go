@@ -0,0 +1,74 @@
+package snowflake
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ExternalAccessIntegration is a row of the SHOW EXTERNAL ACCESS INTEGRATIONS output.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/show-external-access-integrations)
+type ExternalAccessIntegration struct {
+	Name    sql.NullString `db:"name"`
+	Enabled sql.NullBool   `db:"enabled"`
+	Comment sql.NullString `db:"comment"`
+}
+
+// ListExternalAccessIntegrations returns the external access integrations in the account, optionally filtered by a LIKE pattern.
+func ListExternalAccessIntegrations(pattern string, db *sql.DB) ([]ExternalAccessIntegration, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW EXTERNAL ACCESS INTEGRATIONS")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	rows, err := Query(db, stmt.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbs := []ExternalAccessIntegration{}
+	if err := sqlx.StructScan(rows, &dbs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Println("[DEBUG] no external access integrations found")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
+	}
+	return dbs, nil
+}
+
+// DescribeExternalAccessIntegrationNetworkRules returns the ALLOWED_NETWORK_RULES property of the
+// given external access integration, which SHOW EXTERNAL ACCESS INTEGRATIONS does not report.
+func DescribeExternalAccessIntegrationNetworkRules(name string, db *sql.DB) ([]string, error) {
+	stmt := fmt.Sprintf(`DESCRIBE INTEGRATION "%v"`, name)
+	rows, err := Query(db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var k, pType string
+	var v, unused interface{}
+	rules := []string{}
+	for rows.Next() {
+		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
+			return nil, err
+		}
+		if k != "ALLOWED_NETWORK_RULES" {
+			continue
+		}
+		value, _ := v.(string)
+		value = strings.Trim(value, "[]")
+		for _, rule := range strings.Split(value, ",") {
+			if rule = strings.TrimSpace(rule); rule != "" {
+				rules = append(rules, rule)
+			}
+		}
+	}
+	return rules, rows.Err()
+}
@@ -0,0 +1,45 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListExternalAccessIntegrations(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"name", "type", "category", "enabled", "comment", "created_on",
+	}).AddRow("TEST_EAI", "EXTERNAL_ACCESS", "SECURITY", true, "great comment", "")
+	mock.ExpectQuery(`^SHOW EXTERNAL ACCESS INTEGRATIONS LIKE 'test%'$`).WillReturnRows(rows)
+
+	integrations, err := ListExternalAccessIntegrations("test%", mockDB)
+	r.NoError(err)
+	r.Len(integrations, 1)
+	r.Equal("TEST_EAI", integrations[0].Name.String)
+	r.True(integrations[0].Enabled.Bool)
+	r.Equal("great comment", integrations[0].Comment.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDescribeExternalAccessIntegrationNetworkRules(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{"property", "property_type", "property_value", "property_default"}).
+		AddRow("ENABLED", "Boolean", "true", "false").
+		AddRow("ALLOWED_NETWORK_RULES", "List", "[DB.SCHEMA.RULE_A, DB.SCHEMA.RULE_B]", "[]")
+	mock.ExpectQuery(`^DESCRIBE INTEGRATION "TEST_EAI"$`).WillReturnRows(rows)
+
+	rules, err := DescribeExternalAccessIntegrationNetworkRules("TEST_EAI", mockDB)
+	r.NoError(err)
+	r.Equal([]string{"DB.SCHEMA.RULE_A", "DB.SCHEMA.RULE_B"}, rules)
+	r.NoError(mock.ExpectationsWereMet())
+}
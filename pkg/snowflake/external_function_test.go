@@ -24,6 +24,21 @@ func TestExternalFunctionCreate(t *testing.T) {
 	r.Equal(expected, s.Create())
 }
 
+func TestExternalFunctionCreateWithContextHeaders(t *testing.T) {
+	r := require.New(t)
+	s := NewExternalFunctionBuilder("test_function", "test_db", "test_schema")
+	s.WithArgs([]map[string]string{{"name": "data", "type": "varchar"}})
+	s.WithReturnType("varchar")
+	s.WithNullInputBehavior("CALLED ON NULL INPUT")
+	s.WithReturnBehavior("VOLATILE")
+	s.WithAPIIntegration("test_api_integration_01")
+	s.WithContextHeaders([]string{"CURRENT_ACCOUNT", "CURRENT_ROLE"})
+	s.WithURLOfProxyAndResource("https://123456.execute-api.us-west-2.amazonaws.com/prod/test_func")
+
+	expected := `CREATE EXTERNAL FUNCTION "test_db"."test_schema"."test_function" (data varchar) RETURNS varchar NULL CALLED ON NULL INPUT VOLATILE API_INTEGRATION = 'test_api_integration_01' CONTEXT_HEADERS = (CURRENT_ACCOUNT, CURRENT_ROLE) AS 'https://123456.execute-api.us-west-2.amazonaws.com/prod/test_func'`
+	r.Equal(expected, s.Create())
+}
+
 func TestExternalFunctionDrop(t *testing.T) {
 	r := require.New(t)
 
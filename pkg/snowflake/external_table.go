@@ -214,6 +214,9 @@ type ExternalTable struct {
 	SchemaName        sql.NullString `db:"schema_name"`
 	Comment           sql.NullString `db:"comment"`
 	Owner             sql.NullString `db:"owner"`
+	InvalidReason     sql.NullString `db:"invalid_reason"`
+	Location          sql.NullString `db:"location"`
+	FileFormatType    sql.NullString `db:"file_format_type"`
 }
 
 func ScanExternalTable(row *sqlx.Row) (*ExternalTable, error) {
@@ -222,9 +225,14 @@ func ScanExternalTable(row *sqlx.Row) (*ExternalTable, error) {
 	return t, e
 }
 
-func ListExternalTables(databaseName string, schemaName string, db *sql.DB) ([]ExternalTable, error) {
-	stmt := fmt.Sprintf(`SHOW EXTERNAL TABLES IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListExternalTables(databaseName string, schemaName string, pattern string, db *sql.DB) ([]ExternalTable, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW EXTERNAL TABLES")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	stmt.WriteString(fmt.Sprintf(` IN SCHEMA "%s"."%v"`, databaseName, schemaName))
+	rows, err := Query(db, stmt.String())
 	if err != nil {
 		return nil, err
 	}
@@ -236,7 +244,7 @@ func ListExternalTables(databaseName string, schemaName string, db *sql.DB) ([]E
 			log.Println("[DEBUG] no external tables found")
 			return nil, nil
 		}
-		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
 	}
 	return dbs, nil
 }
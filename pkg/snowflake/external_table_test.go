@@ -3,6 +3,7 @@ package snowflake
 import (
 	"testing"
 
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -40,3 +41,24 @@ func TestExternalTableShow(t *testing.T) {
 	s := NewExternalTableBuilder("test_table", "test_db", "test_schema")
 	r.Equal(`SHOW EXTERNAL TABLES LIKE 'test_table' IN SCHEMA "test_db"."test_schema"`, s.Show())
 }
+
+func TestListExternalTables(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "invalid", "invalid_reason", "owner", "comment", "stage", "location", "file_format_name", "file_format_type",
+	}).AddRow("", "test_table", "test_db", "test_schema", false, "", "ACCOUNTADMIN", "great comment", "", "@test_stage/", "", "CSV")
+	mock.ExpectQuery(`^SHOW EXTERNAL TABLES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	externalTables, err := ListExternalTables("test_db", "test_schema", "test%", mockDB)
+	r.NoError(err)
+	r.Len(externalTables, 1)
+	r.Equal("test_table", externalTables[0].ExternalTableName.String)
+	r.Equal("ACCOUNTADMIN", externalTables[0].Owner.String)
+	r.Equal("@test_stage/", externalTables[0].Location.String)
+	r.Equal("CSV", externalTables[0].FileFormatType.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -331,15 +331,24 @@ func ScanFunctions(rows *sqlx.Rows) ([]*Function, error) {
 }
 
 type UserFunctions struct {
-	Name        sql.NullString `db:"name"`
-	Arguments   sql.NullString `db:"arguments"`
-	Description sql.NullString `db:"description"`
-	Language    sql.NullString `db:"language"`
+	Name               sql.NullString `db:"name"`
+	SchemaName         sql.NullString `db:"schema_name"`
+	DatabaseName       sql.NullString `db:"catalog_name"`
+	Arguments          sql.NullString `db:"arguments"`
+	Description        sql.NullString `db:"description"`
+	Language           sql.NullString `db:"language"`
+	IsSecure           sql.NullString `db:"is_secure"`
+	IsExternalFunction sql.NullString `db:"is_external_function"`
 }
 
-func ListUserFunctions(databaseName string, schemaName string, db *sql.DB) ([]UserFunctions, error) {
-	stmt := fmt.Sprintf(`SHOW USER FUNCTIONS IN SCHEMA "%v"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListUserFunctions(databaseName string, schemaName string, pattern string, db *sql.DB) ([]UserFunctions, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW USER FUNCTIONS")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	stmt.WriteString(fmt.Sprintf(` IN SCHEMA "%v"."%v"`, databaseName, schemaName))
+	rows, err := Query(db, stmt.String())
 	if err != nil {
 		return nil, err
 	}
@@ -351,7 +360,7 @@ func ListUserFunctions(databaseName string, schemaName string, db *sql.DB) ([]Us
 			log.Println("[DEBUG] no functions found")
 			return nil, nil
 		}
-		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
 	}
-	return dbs, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+	return dbs, nil
 }
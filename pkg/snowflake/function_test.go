@@ -3,6 +3,7 @@ package snowflake
 import (
 	"testing"
 
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -279,3 +280,25 @@ func TestFunctionArgumentsSignature(t *testing.T) {
 	sign, _ = s.ArgumentsSignature()
 	r.Equal("test_func(VARCHAR, DATE) RETURN VARCHAR", sign)
 }
+
+func TestListUserFunctions(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "schema_name", "is_builtin", "is_aggregate", "is_ansi", "min_num_arguments", "max_num_arguments", "arguments", "description", "catalog_name", "is_table_function", "valid_for_clustering", "is_secure", "is_external_function", "language", "is_memoizable",
+	}).AddRow("", "TEST_FUNC", "test_schema", "N", "N", "N", 1, 1, "TEST_FUNC(VARCHAR) RETURN VARCHAR", "user-defined function", "test_db", "N", "N", "Y", "N", "JAVASCRIPT", "N")
+	mock.ExpectQuery(`^SHOW USER FUNCTIONS LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	functions, err := ListUserFunctions("test_db", "test_schema", "test%", mockDB)
+	r.NoError(err)
+	r.Len(functions, 1)
+	r.Equal("TEST_FUNC", functions[0].Name.String)
+	r.Equal("test_db", functions[0].DatabaseName.String)
+	r.Equal("JAVASCRIPT", functions[0].Language.String)
+	r.Equal("Y", functions[0].IsSecure.String)
+	r.Equal("N", functions[0].IsExternalFunction.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -23,6 +23,7 @@ const (
 	futureStreamType           futureGrantType = "STREAM"
 	futurePipeType             futureGrantType = "PIPE"
 	futureTaskType             futureGrantType = "TASK"
+	futureAlertType            futureGrantType = "ALERT"
 )
 
 const (
@@ -203,6 +204,17 @@ func FutureTaskGrant(db, schema string) GrantBuilder {
 	}
 }
 
+// FutureAlertGrant returns a pointer to a FutureGrantBuilder for an alert.
+func FutureAlertGrant(db, schema string) GrantBuilder {
+	name, qualifiedName, futureTarget := getNameAndQualifiedName(db, schema)
+	return &FutureGrantBuilder{
+		name:              name,
+		qualifiedName:     qualifiedName,
+		futureGrantType:   futureAlertType,
+		futureGrantTarget: futureTarget,
+	}
+}
+
 // Show returns the SQL that will show all privileges on the grant.
 func (fgb *FutureGrantBuilder) Show() string {
 	return fmt.Sprintf(`SHOW FUTURE GRANTS IN %v %v`, fgb.futureGrantTarget, fgb.qualifiedName)
@@ -252,6 +264,13 @@ func (fge *FutureGrantExecutable) Revoke(p string) []string {
 	}
 }
 
+// RevokeExisting returns the SQL that will revoke the privilege from the grantee on all existing
+// objects of the future grant type, i.e. the objects that already received it through the future grant.
+func (fge *FutureGrantExecutable) RevokeExisting(p string) string {
+	return fmt.Sprintf(`REVOKE %v ON ALL %vS IN %v %v FROM ROLE "%v"`,
+		p, fge.futureGrantType, fge.futureGrantTarget, fge.grantName, fge.granteeName)
+}
+
 // Show returns the SQL that will show all future grants on the schema.
 func (fge *FutureGrantExecutable) Show() string {
 	return fmt.Sprintf(`SHOW FUTURE GRANTS IN %v %v`, fge.futureGrantTarget, fge.grantName)
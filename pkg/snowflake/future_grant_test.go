@@ -204,3 +204,56 @@ func TestFutureFileFormatGrant(t *testing.T) {
 	revoke = fvgd.Role("bob").Revoke("USAGE")
 	b.Equal([]string{`REVOKE USAGE ON FUTURE FILE FORMATS IN DATABASE "test_db" FROM ROLE "bob"`}, revoke)
 }
+
+func TestFuturePipeGrant(t *testing.T) {
+	r := require.New(t)
+	fpg := snowflake.FuturePipeGrant("test_db", "PUBLIC")
+	r.Equal("PUBLIC", fpg.Name())
+
+	s := fpg.Show()
+	r.Equal(`SHOW FUTURE GRANTS IN SCHEMA "test_db"."PUBLIC"`, s)
+
+	s = fpg.Role("bob").Grant("OPERATE", false)
+	r.Equal(`GRANT OPERATE ON FUTURE PIPES IN SCHEMA "test_db"."PUBLIC" TO ROLE "bob"`, s)
+
+	revoke := fpg.Role("bob").Revoke("OPERATE")
+	r.Equal([]string{`REVOKE OPERATE ON FUTURE PIPES IN SCHEMA "test_db"."PUBLIC" FROM ROLE "bob"`}, revoke)
+
+	fpgd := snowflake.FuturePipeGrant("test_db", "")
+	r.Equal("test_db", fpgd.Name())
+
+	s = fpgd.Show()
+	r.Equal(`SHOW FUTURE GRANTS IN DATABASE "test_db"`, s)
+
+	s = fpgd.Role("bob").Grant("MONITOR", false)
+	r.Equal(`GRANT MONITOR ON FUTURE PIPES IN DATABASE "test_db" TO ROLE "bob"`, s)
+}
+
+func TestFutureAlertGrant(t *testing.T) {
+	r := require.New(t)
+	fag := snowflake.FutureAlertGrant("test_db", "PUBLIC")
+	r.Equal("PUBLIC", fag.Name())
+
+	s := fag.Show()
+	r.Equal(`SHOW FUTURE GRANTS IN SCHEMA "test_db"."PUBLIC"`, s)
+
+	s = fag.Role("bob").Grant("OPERATE", false)
+	r.Equal(`GRANT OPERATE ON FUTURE ALERTS IN SCHEMA "test_db"."PUBLIC" TO ROLE "bob"`, s)
+
+	revoke := fag.Role("bob").Revoke("OPERATE")
+	r.Equal([]string{`REVOKE OPERATE ON FUTURE ALERTS IN SCHEMA "test_db"."PUBLIC" FROM ROLE "bob"`}, revoke)
+
+	fagd := snowflake.FutureAlertGrant("test_db", "")
+	s = fagd.Role("bob").Grant("OPERATE", false)
+	r.Equal(`GRANT OPERATE ON FUTURE ALERTS IN DATABASE "test_db" TO ROLE "bob"`, s)
+}
+
+func TestFutureGrantRevokeExisting(t *testing.T) {
+	r := require.New(t)
+
+	fvg := snowflake.FutureViewGrant("test_db", "PUBLIC").Role("bob").(*snowflake.FutureGrantExecutable)
+	r.Equal(`REVOKE SELECT ON ALL VIEWS IN SCHEMA "test_db"."PUBLIC" FROM ROLE "bob"`, fvg.RevokeExisting("SELECT"))
+
+	fvgd := snowflake.FutureMaterializedViewGrant("test_db", "").Role("bob").(*snowflake.FutureGrantExecutable)
+	r.Equal(`REVOKE SELECT ON ALL MATERIALIZED VIEWS IN DATABASE "test_db" FROM ROLE "bob"`, fvgd.RevokeExisting("SELECT"))
+}
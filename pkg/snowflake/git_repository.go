@@ -0,0 +1,48 @@
+package snowflake
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// GitRepository is a row of the SHOW GIT REPOSITORIES output.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/show-git-repositories)
+type GitRepository struct {
+	Name           sql.NullString `db:"name"`
+	DatabaseName   sql.NullString `db:"database_name"`
+	SchemaName     sql.NullString `db:"schema_name"`
+	Origin         sql.NullString `db:"origin"`
+	APIIntegration sql.NullString `db:"api_integration"`
+	Owner          sql.NullString `db:"owner"`
+	Comment        sql.NullString `db:"comment"`
+}
+
+// ListGitRepositories returns the git repositories in the given schema, optionally filtered by a LIKE pattern.
+func ListGitRepositories(databaseName string, schemaName string, pattern string, db *sql.DB) ([]GitRepository, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW GIT REPOSITORIES")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	stmt.WriteString(fmt.Sprintf(` IN SCHEMA "%v"."%v"`, databaseName, schemaName))
+	rows, err := Query(db, stmt.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbs := []GitRepository{}
+	if err := sqlx.StructScan(rows, &dbs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Println("[DEBUG] no git repositories found")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
+	}
+	return dbs, nil
+}
@@ -0,0 +1,28 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListGitRepositories(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "origin", "api_integration", "git_credentials", "owner", "comment",
+	}).AddRow("", "test_repo", "test_db", "test_schema", "https://github.com/example/repo.git", "GIT_API", "", "ACCOUNTADMIN", "great comment")
+	mock.ExpectQuery(`^SHOW GIT REPOSITORIES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	repositories, err := ListGitRepositories("test_db", "test_schema", "test%", mockDB)
+	r.NoError(err)
+	r.Len(repositories, 1)
+	r.Equal("test_repo", repositories[0].Name.String)
+	r.Equal("https://github.com/example/repo.git", repositories[0].Origin.String)
+	r.Equal("GIT_API", repositories[0].APIIntegration.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
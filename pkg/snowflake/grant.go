@@ -34,6 +34,7 @@ const (
 	maskingPolicyType    grantType = "MASKING POLICY"
 	pipeType             grantType = "PIPE"
 	taskType             grantType = "TASK"
+	alertType            grantType = "ALERT"
 	rowAccessPolicyType  grantType = "ROW ACCESS POLICY"
 	tagType              grantType = "TAG"
 	userGrantType        grantType = "USER"
@@ -299,6 +300,15 @@ func TaskGrant(db, schema, task string) GrantBuilder {
 	}
 }
 
+// AlertGrant returns a pointer to a CurrentGrantBuilder for an alert.
+func AlertGrant(db, schema, alert string) GrantBuilder {
+	return &CurrentGrantBuilder{
+		name:          alert,
+		qualifiedName: fmt.Sprintf(`"%v"."%v"."%v"`, db, schema, alert),
+		grantType:     alertType,
+	}
+}
+
 // RowAccessPolicyGrant returns a pointer to a CurrentGrantBuilder for a masking policy.
 func RowAccessPolicyGrant(db, schema, rowAccessPolicy string) GrantBuilder {
 	return &CurrentGrantBuilder{
@@ -317,6 +327,20 @@ func TagGrant(db, schema, tag string) GrantBuilder {
 	}
 }
 
+// ObjectGrant returns a pointer to a CurrentGrantBuilder for an object of any type. The name
+// parts are quoted and joined, so pass the database, schema and object names separately.
+func ObjectGrant(objectType string, nameParts ...string) GrantBuilder {
+	quoted := make([]string, 0, len(nameParts))
+	for _, p := range nameParts {
+		quoted = append(quoted, fmt.Sprintf(`"%v"`, p))
+	}
+	return &CurrentGrantBuilder{
+		name:          nameParts[len(nameParts)-1],
+		qualifiedName: strings.Join(quoted, "."),
+		grantType:     grantType(objectType),
+	}
+}
+
 type granteeType string
 
 const (
@@ -358,14 +382,36 @@ func (gb *CurrentGrantBuilder) Share(n string) GrantExecutable {
 func (ge *CurrentGrantExecutable) Grant(p string, w bool) string {
 	var template string
 	if p == `OWNERSHIP` { //nolint:gocritic // todo: please fix this
-		template = `GRANT %v ON %v %v TO %v "%v" COPY CURRENT GRANTS`
+		template = `GRANT %v ON %v %v TO %v %v COPY CURRENT GRANTS`
 	} else if w {
-		template = `GRANT %v ON %v %v TO %v "%v" WITH GRANT OPTION`
+		template = `GRANT %v ON %v %v TO %v %v WITH GRANT OPTION`
 	} else {
-		template = `GRANT %v ON %v %v TO %v "%v"`
+		template = `GRANT %v ON %v %v TO %v %v`
 	}
 	return fmt.Sprintf(template,
-		p, ge.grantType, ge.grantName, ge.granteeType, ge.granteeName)
+		p, ge.grantType, ge.grantName, ge.granteeType, ge.granteeIdentifier())
+}
+
+// TransferOwnership returns the SQL that will transfer ownership of the grant to the grantee.
+// currentGrants is either COPY or REVOKE and specifies whether the existing outbound privileges on
+// the object are kept or removed by the transfer.
+func (ge *CurrentGrantExecutable) TransferOwnership(currentGrants string) string {
+	return fmt.Sprintf(`GRANT OWNERSHIP ON %v %v TO %v %v %v CURRENT GRANTS`,
+		ge.grantType, ge.grantName, ge.granteeType, ge.granteeIdentifier(), strings.ToUpper(currentGrants))
+}
+
+// granteeIdentifier returns the quoted name of the grantee. Share names may be qualified with the
+// identifier of the providing account (account.share or org.account.share), in which case each
+// part is quoted separately.
+func (ge *CurrentGrantExecutable) granteeIdentifier() string {
+	if ge.granteeType != shareType {
+		return fmt.Sprintf(`"%v"`, ge.granteeName)
+	}
+	parts := strings.Split(ge.granteeName, ".")
+	for i, part := range parts {
+		parts[i] = fmt.Sprintf(`"%v"`, part)
+	}
+	return strings.Join(parts, ".")
 }
 
 // Revoke returns the SQL that will revoke privileges on the grant from the grantee.
@@ -379,14 +425,14 @@ func (ge *CurrentGrantExecutable) Revoke(p string) []string {
 		}
 	}
 	return []string{
-		fmt.Sprintf(`REVOKE %v ON %v %v FROM %v "%v"`,
-			p, ge.grantType, ge.grantName, ge.granteeType, ge.granteeName),
+		fmt.Sprintf(`REVOKE %v ON %v %v FROM %v %v`,
+			p, ge.grantType, ge.grantName, ge.granteeType, ge.granteeIdentifier()),
 	}
 }
 
 // Show returns the SQL that will show all grants of the grantee.
 func (ge *CurrentGrantExecutable) Show() string {
-	return fmt.Sprintf(`SHOW GRANTS OF %v "%v"`, ge.granteeType, ge.granteeName)
+	return fmt.Sprintf(`SHOW GRANTS OF %v %v`, ge.granteeType, ge.granteeIdentifier())
 }
 
 type GrantDetail struct {
@@ -398,6 +444,9 @@ type GrantDetail struct {
 	GranteeName sql.NullString `db:"grantee_name"`
 	GrantOption sql.NullString `db:"grant_option"`
 	GrantedBy   sql.NullString `db:"granted_by"`
+	// SHOW FUTURE GRANTS reports grant_on and grant_to instead of granted_on and granted_to
+	GrantOn sql.NullString `db:"grant_on"`
+	GrantTo sql.NullString `db:"grant_to"`
 }
 
 func queryGrants(db *sql.DB, stmt string) ([]GrantDetail, error) {
@@ -419,6 +468,12 @@ func queryGrants(db *sql.DB, stmt string) ([]GrantDetail, error) {
 	return grantDetails, nil
 }
 
+// ShowGrants returns the grants shown by builder, that is the grants on an object for a current
+// grant builder and the future grants in its database or schema for a future grant builder.
+func ShowGrants(db *sql.DB, builder GrantBuilder) ([]GrantDetail, error) {
+	return queryGrants(db, builder.Show())
+}
+
 func ShowGrantsOn(db *sql.DB, objectType, objectName string) ([]GrantDetail, error) {
 	stmt := fmt.Sprintf(`SHOW GRANTS ON %v %v`, objectType, objectName)
 	return queryGrants(db, stmt)
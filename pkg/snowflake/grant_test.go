@@ -3,6 +3,7 @@ package snowflake_test
 import (
 	"testing"
 
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/stretchr/testify/require"
 )
@@ -61,6 +62,21 @@ func TestSchemaGrant(t *testing.T) {
 	r.Equal([]string{`SET currentRole=CURRENT_ROLE()`, `GRANT OWNERSHIP ON SCHEMA "test_db"."testSchema" TO ROLE IDENTIFIER($currentRole) COPY CURRENT GRANTS`}, revoke)
 }
 
+func TestGrantToQualifiedShare(t *testing.T) {
+	r := require.New(t)
+	vg := snowflake.ViewGrant("test_db", "PUBLIC", "testView")
+
+	s := vg.Share("ab12345.my_share").Grant("SELECT", false)
+	r.Equal(`GRANT SELECT ON VIEW "test_db"."PUBLIC"."testView" TO SHARE "ab12345"."my_share"`, s)
+
+	revoke := vg.Share("myorg.myaccount.my_share").Revoke("SELECT")
+	r.Equal([]string{`REVOKE SELECT ON VIEW "test_db"."PUBLIC"."testView" FROM SHARE "myorg"."myaccount"."my_share"`}, revoke)
+
+	// Role names are always quoted as a whole.
+	s = vg.Role("my.role").Grant("SELECT", false)
+	r.Equal(`GRANT SELECT ON VIEW "test_db"."PUBLIC"."testView" TO ROLE "my.role"`, s)
+}
+
 func TestViewGrant(t *testing.T) {
 	r := require.New(t)
 	vg := snowflake.ViewGrant("test_db", "PUBLIC", "testView")
@@ -223,6 +239,48 @@ func TestProcedureGrant(t *testing.T) {
 	r.Equal([]string{`SET currentRole=CURRENT_ROLE()`, `GRANT OWNERSHIP ON PROCEDURE "test_db"."PUBLIC"."testProcedure"(ARRAY, STRING) TO ROLE IDENTIFIER($currentRole) COPY CURRENT GRANTS`}, revoke)
 }
 
+func TestPipeGrant(t *testing.T) {
+	r := require.New(t)
+	pg := snowflake.PipeGrant("test_db", "PUBLIC", "testPipe")
+	r.Equal("testPipe", pg.Name())
+
+	s := pg.Show()
+	r.Equal(`SHOW GRANTS ON PIPE "test_db"."PUBLIC"."testPipe"`, s)
+
+	s = pg.Role("bob").Grant("MONITOR", false)
+	r.Equal(`GRANT MONITOR ON PIPE "test_db"."PUBLIC"."testPipe" TO ROLE "bob"`, s)
+
+	s = pg.Role("bob").Grant("OPERATE", true)
+	r.Equal(`GRANT OPERATE ON PIPE "test_db"."PUBLIC"."testPipe" TO ROLE "bob" WITH GRANT OPTION`, s)
+
+	revoke := pg.Role("bob").Revoke("OPERATE")
+	r.Equal([]string{`REVOKE OPERATE ON PIPE "test_db"."PUBLIC"."testPipe" FROM ROLE "bob"`}, revoke)
+
+	s = pg.Role("bob").Grant("OWNERSHIP", false)
+	r.Equal(`GRANT OWNERSHIP ON PIPE "test_db"."PUBLIC"."testPipe" TO ROLE "bob" COPY CURRENT GRANTS`, s)
+
+	revoke = pg.Role("bob").Revoke("OWNERSHIP")
+	r.Equal([]string{`SET currentRole=CURRENT_ROLE()`, `GRANT OWNERSHIP ON PIPE "test_db"."PUBLIC"."testPipe" TO ROLE IDENTIFIER($currentRole) COPY CURRENT GRANTS`}, revoke)
+}
+
+func TestAlertGrant(t *testing.T) {
+	r := require.New(t)
+	ag := snowflake.AlertGrant("test_db", "PUBLIC", "testAlert")
+	r.Equal("testAlert", ag.Name())
+
+	s := ag.Show()
+	r.Equal(`SHOW GRANTS ON ALERT "test_db"."PUBLIC"."testAlert"`, s)
+
+	s = ag.Role("bob").Grant("OPERATE", false)
+	r.Equal(`GRANT OPERATE ON ALERT "test_db"."PUBLIC"."testAlert" TO ROLE "bob"`, s)
+
+	revoke := ag.Role("bob").Revoke("OPERATE")
+	r.Equal([]string{`REVOKE OPERATE ON ALERT "test_db"."PUBLIC"."testAlert" FROM ROLE "bob"`}, revoke)
+
+	s = ag.Role("bob").Grant("OWNERSHIP", false)
+	r.Equal(`GRANT OWNERSHIP ON ALERT "test_db"."PUBLIC"."testAlert" TO ROLE "bob" COPY CURRENT GRANTS`, s)
+}
+
 func TestWarehouseGrant(t *testing.T) {
 	r := require.New(t)
 	wg := snowflake.WarehouseGrant("test_warehouse")
@@ -331,3 +389,43 @@ func TestShowGrantsOf(t *testing.T) {
 	s = snowflake.ViewGrant("test_db", "PUBLIC", "testView").Share("testShare").Show()
 	r.Equal(`SHOW GRANTS OF SHARE "testShare"`, s)
 }
+
+func TestObjectGrant(t *testing.T) {
+	r := require.New(t)
+	og := snowflake.ObjectGrant("TABLE", "test_db", "PUBLIC", "test_table")
+	r.Equal("test_table", og.Name())
+	r.Equal("TABLE", og.GrantType())
+	r.Equal(`SHOW GRANTS ON TABLE "test_db"."PUBLIC"."test_table"`, og.Show())
+	r.Equal(`GRANT SELECT ON TABLE "test_db"."PUBLIC"."test_table" TO ROLE "bob"`, og.Role("bob").Grant("SELECT", false))
+
+	og = snowflake.ObjectGrant("WAREHOUSE", "test_wh")
+	r.Equal(`REVOKE USAGE ON WAREHOUSE "test_wh" FROM ROLE "bob"`, og.Role("bob").Revoke("USAGE")[0])
+}
+
+func TestTransferOwnership(t *testing.T) {
+	r := require.New(t)
+	vg := snowflake.ViewGrant("test_db", "PUBLIC", "testView").Role("bob").(*snowflake.CurrentGrantExecutable)
+
+	r.Equal(`GRANT OWNERSHIP ON VIEW "test_db"."PUBLIC"."testView" TO ROLE "bob" COPY CURRENT GRANTS`, vg.TransferOwnership("COPY"))
+	r.Equal(`GRANT OWNERSHIP ON VIEW "test_db"."PUBLIC"."testView" TO ROLE "bob" REVOKE CURRENT GRANTS`, vg.TransferOwnership("revoke"))
+}
+
+func TestShowGrantsFuture(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{"created_on", "privilege", "grant_on", "name", "grant_to", "grantee_name", "grant_option"}).
+		AddRow("", "SELECT", "STREAM", "DB.PUBLIC.<STREAM>", "ROLE", "READER", "false")
+	mock.ExpectQuery(`^SHOW FUTURE GRANTS IN SCHEMA "db"."PUBLIC"$`).WillReturnRows(rows)
+
+	grants, err := snowflake.ShowGrants(mockDB, snowflake.FutureStreamGrant("db", "PUBLIC"))
+	r.NoError(err)
+	r.Len(grants, 1)
+	r.Equal("SELECT", grants[0].Privilege.String)
+	r.Equal("STREAM", grants[0].GrantOn.String)
+	r.Equal("ROLE", grants[0].GrantTo.String)
+	r.Equal("READER", grants[0].GranteeName.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
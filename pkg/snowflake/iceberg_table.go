@@ -0,0 +1,49 @@
+package snowflake
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// IcebergTable is a row of the SHOW ICEBERG TABLES output.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/show-iceberg-tables)
+type IcebergTable struct {
+	Name               sql.NullString `db:"name"`
+	DatabaseName       sql.NullString `db:"database_name"`
+	SchemaName         sql.NullString `db:"schema_name"`
+	CatalogName        sql.NullString `db:"catalog_name"`
+	ExternalVolumeName sql.NullString `db:"external_volume_name"`
+	BaseLocation       sql.NullString `db:"base_location"`
+	Owner              sql.NullString `db:"owner"`
+	Comment            sql.NullString `db:"comment"`
+}
+
+// ListIcebergTables returns the iceberg tables in the given schema, optionally filtered by a LIKE pattern.
+func ListIcebergTables(databaseName string, schemaName string, pattern string, db *sql.DB) ([]IcebergTable, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW ICEBERG TABLES")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	stmt.WriteString(fmt.Sprintf(` IN SCHEMA "%v"."%v"`, databaseName, schemaName))
+	rows, err := Query(db, stmt.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbs := []IcebergTable{}
+	if err := sqlx.StructScan(rows, &dbs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Println("[DEBUG] no iceberg tables found")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
+	}
+	return dbs, nil
+}
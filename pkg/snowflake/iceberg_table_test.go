@@ -0,0 +1,29 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListIcebergTables(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "owner", "comment", "external_volume_name", "catalog_name", "iceberg_table_type", "catalog_table_name", "catalog_namespace", "base_location",
+	}).AddRow("", "test_table", "test_db", "test_schema", "ACCOUNTADMIN", "great comment", "TEST_VOLUME", "SNOWFLAKE", "MANAGED", "", "", "test_table/")
+	mock.ExpectQuery(`^SHOW ICEBERG TABLES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	tables, err := ListIcebergTables("test_db", "test_schema", "test%", mockDB)
+	r.NoError(err)
+	r.Len(tables, 1)
+	r.Equal("test_table", tables[0].Name.String)
+	r.Equal("SNOWFLAKE", tables[0].CatalogName.String)
+	r.Equal("TEST_VOLUME", tables[0].ExternalVolumeName.String)
+	r.Equal("test_table/", tables[0].BaseLocation.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,47 @@
+package snowflake
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ImageRepository is a row of the SHOW IMAGE REPOSITORIES output.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/show-image-repositories)
+type ImageRepository struct {
+	Name          sql.NullString `db:"name"`
+	DatabaseName  sql.NullString `db:"database_name"`
+	SchemaName    sql.NullString `db:"schema_name"`
+	RepositoryURL sql.NullString `db:"repository_url"`
+	Owner         sql.NullString `db:"owner"`
+	Comment       sql.NullString `db:"comment"`
+}
+
+// ListImageRepositories returns the image repositories in the given schema, optionally filtered by a LIKE pattern.
+func ListImageRepositories(databaseName string, schemaName string, pattern string, db *sql.DB) ([]ImageRepository, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW IMAGE REPOSITORIES")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	stmt.WriteString(fmt.Sprintf(` IN SCHEMA "%v"."%v"`, databaseName, schemaName))
+	rows, err := Query(db, stmt.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbs := []ImageRepository{}
+	if err := sqlx.StructScan(rows, &dbs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Println("[DEBUG] no image repositories found")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
+	}
+	return dbs, nil
+}
@@ -0,0 +1,28 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListImageRepositories(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "repository_url", "owner", "comment",
+	}).AddRow("", "test_repo", "test_db", "test_schema", "org-acct.registry.snowflakecomputing.com/test_db/test_schema/test_repo", "ACCOUNTADMIN", "great comment")
+	mock.ExpectQuery(`^SHOW IMAGE REPOSITORIES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	repositories, err := ListImageRepositories("test_db", "test_schema", "test%", mockDB)
+	r.NoError(err)
+	r.Len(repositories, 1)
+	r.Equal("test_repo", repositories[0].Name.String)
+	r.Equal("org-acct.registry.snowflakecomputing.com/test_db/test_schema/test_repo", repositories[0].RepositoryURL.String)
+	r.Equal("ACCOUNTADMIN", repositories[0].Owner.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,51 @@
+package snowflake
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Integration is a row of the SHOW INTEGRATIONS output.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/show-integrations)
+type Integration struct {
+	Name      sql.NullString `db:"name"`
+	Type      sql.NullString `db:"type"`
+	Category  sql.NullString `db:"category"`
+	Enabled   sql.NullBool   `db:"enabled"`
+	Comment   sql.NullString `db:"comment"`
+	CreatedOn sql.NullString `db:"created_on"`
+}
+
+// ListIntegrations returns the integrations in the account, optionally filtered by integration type
+// (API, NOTIFICATION, SECURITY, STORAGE or EXTERNAL ACCESS) and by a LIKE pattern.
+func ListIntegrations(integrationType string, pattern string, db *sql.DB) ([]Integration, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW ")
+	if integrationType != "" {
+		stmt.WriteString(fmt.Sprintf("%v ", strings.ToUpper(integrationType)))
+	}
+	stmt.WriteString("INTEGRATIONS")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	rows, err := Query(db, stmt.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbs := []Integration{}
+	if err := sqlx.StructScan(rows, &dbs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Println("[DEBUG] no integrations found")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
+	}
+	return dbs, nil
+}
@@ -0,0 +1,46 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListIntegrations(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"name", "type", "category", "enabled", "comment", "created_on",
+	}).AddRow("TEST_INTEGRATION", "EXTERNAL_API", "API", true, "great comment", "2023-01-01 00:00:00.000 -0800")
+	mock.ExpectQuery(`^SHOW INTEGRATIONS LIKE 'test%'$`).WillReturnRows(rows)
+
+	integrations, err := ListIntegrations("", "test%", mockDB)
+	r.NoError(err)
+	r.Len(integrations, 1)
+	r.Equal("TEST_INTEGRATION", integrations[0].Name.String)
+	r.Equal("EXTERNAL_API", integrations[0].Type.String)
+	r.Equal("API", integrations[0].Category.String)
+	r.True(integrations[0].Enabled.Bool)
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestListIntegrationsByType(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{"name", "type", "category", "enabled", "comment", "created_on"}).
+		AddRow("TEST_INTEGRATION", "EXTERNAL_ACCESS", "SECURITY", true, "", "2023-01-01 00:00:00.000 -0800")
+	mock.ExpectQuery(`^SHOW EXTERNAL ACCESS INTEGRATIONS$`).WillReturnRows(rows)
+
+	integrations, err := ListIntegrations("external access", "", mockDB)
+	r.NoError(err)
+	r.Len(integrations, 1)
+	r.Equal("SECURITY", integrations[0].Category.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/jmoiron/sqlx"
@@ -200,6 +201,48 @@ func (vb *MaterializedViewBuilder) Drop() string {
 	return fmt.Sprintf(`DROP MATERIALIZED VIEW %v`, vb.QualifiedName())
 }
 
+var (
+	materializedViewJoin             = regexp.MustCompile(`(?i)\bJOIN\b`)
+	materializedViewCommaJoin        = regexp.MustCompile(`(?i)\bFROM\s+[^\s,()]+(\s+(AS\s+)?\w+)?\s*,`)
+	materializedViewSetOperator      = regexp.MustCompile(`(?i)\b(UNION|INTERSECT|EXCEPT|MINUS)\b`)
+	materializedViewHaving           = regexp.MustCompile(`(?i)\bHAVING\b`)
+	materializedViewOrderBy          = regexp.MustCompile(`(?i)\bORDER\s+BY\b`)
+	materializedViewLimit            = regexp.MustCompile(`(?i)\b(LIMIT|TOP|FETCH)\b`)
+	materializedViewNondeterministic = regexp.MustCompile(`(?i)\b(CURRENT_DATE|CURRENT_TIME|CURRENT_TIMESTAMP|LOCALTIME|LOCALTIMESTAMP|SYSDATE|GETDATE|RANDOM|UUID_STRING|SEQ[1248])\b`)
+)
+
+// MaterializedViewRestrictions does a best-effort scan of a materialized view statement for
+// constructs Snowflake does not allow in materialized views and returns a description of each one
+// found. String literals are ignored, as in ChangeTrackingIncompatibilities. Subqueries are not
+// inspected separately, so a join inside one is reported as well.
+// [Snowflake Reference](https://docs.snowflake.com/en/user-guide/views-materialized.html#limitations-on-creating-materialized-views)
+func MaterializedViewRestrictions(statement string) []string {
+	s := viewStringLiteral.ReplaceAllString(statement, "''")
+	var found []string
+	if materializedViewJoin.MatchString(s) || materializedViewCommaJoin.MatchString(s) {
+		found = append(found, "a join (a materialized view can only query a single table)")
+	}
+	if materializedViewSetOperator.MatchString(s) {
+		found = append(found, "a set operator (UNION, INTERSECT, EXCEPT, MINUS)")
+	}
+	if materializedViewHaving.MatchString(s) {
+		found = append(found, "HAVING")
+	}
+	if materializedViewOrderBy.MatchString(s) {
+		found = append(found, "ORDER BY")
+	}
+	if materializedViewLimit.MatchString(s) {
+		found = append(found, "LIMIT, TOP or FETCH")
+	}
+	if viewWindow.MatchString(s) {
+		found = append(found, "window function (OVER)")
+	}
+	if match := materializedViewNondeterministic.FindString(s); match != "" {
+		found = append(found, fmt.Sprintf("non-deterministic function %v", strings.ToUpper(match)))
+	}
+	return found
+}
+
 type MaterializedView struct {
 	Comment       sql.NullString `db:"comment"`
 	IsSecure      bool           `db:"is_secure"`
@@ -208,6 +251,20 @@ type MaterializedView struct {
 	Text          sql.NullString `db:"text"`
 	DatabaseName  sql.NullString `db:"database_name"`
 	WarehouseName sql.NullString `db:"warehouse_name"`
+	Owner         sql.NullString `db:"owner"`
+	ClusterBy     sql.NullString `db:"cluster_by"`
+	Rows          sql.NullInt64  `db:"rows"`
+	Bytes         sql.NullInt64  `db:"bytes"`
+	InvalidReason sql.NullString `db:"invalid_reason"`
+	BehindBy      sql.NullString `db:"behind_by"`
+	RefreshedOn   sql.NullString `db:"refreshed_on"`
+}
+
+// IsStale reports whether the materialized view is behind its base table, i.e. SHOW MATERIALIZED
+// VIEWS reports a behind_by other than 0s.
+func (mv *MaterializedView) IsStale() bool {
+	behindBy := strings.TrimSpace(mv.BehindBy.String)
+	return behindBy != "" && behindBy != "0s"
 }
 
 func ScanMaterializedView(row *sqlx.Row) (*MaterializedView, error) {
@@ -216,9 +273,14 @@ func ScanMaterializedView(row *sqlx.Row) (*MaterializedView, error) {
 	return r, err
 }
 
-func ListMaterializedViews(databaseName string, schemaName string, db *sql.DB) ([]MaterializedView, error) {
-	stmt := fmt.Sprintf(`SHOW MATERIALIZED VIEWS IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListMaterializedViews(databaseName string, schemaName string, pattern string, db *sql.DB) ([]MaterializedView, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW MATERIALIZED VIEWS")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	stmt.WriteString(fmt.Sprintf(` IN SCHEMA "%s"."%v"`, databaseName, schemaName))
+	rows, err := Query(db, stmt.String())
 	if err != nil {
 		return nil, err
 	}
@@ -230,7 +292,7 @@ func ListMaterializedViews(databaseName string, schemaName string, db *sql.DB) (
 			log.Println("[DEBUG] no materialized views found")
 			return nil, nil
 		}
-		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
 	}
 	return dbs, nil
 }
@@ -0,0 +1,67 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListMaterializedViews(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "reserved", "database_name", "schema_name", "cluster_by", "rows", "bytes", "owner", "invalid", "invalid_reason", "comment", "text", "is_secure",
+	}).AddRow("", "test_view", "", "test_db", "test_schema", "LINEAR(id)", 10, 1024, "ACCOUNTADMIN", false, "", "great comment", "SELECT 1", true)
+	mock.ExpectQuery(`^SHOW MATERIALIZED VIEWS LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	views, err := ListMaterializedViews("test_db", "test_schema", "test%", mockDB)
+	r.NoError(err)
+	r.Len(views, 1)
+	r.Equal("test_view", views[0].Name.String)
+	r.True(views[0].IsSecure)
+	r.Equal("LINEAR(id)", views[0].ClusterBy.String)
+	r.Equal(int64(10), views[0].Rows.Int64)
+	r.Equal(int64(1024), views[0].Bytes.Int64)
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestScanMaterializedViewFreshness(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "text", "is_secure", "behind_by", "refreshed_on",
+	}).AddRow("", "test_view", "test_db", "test_schema", "SELECT 1", false, "5m", "2023-01-01 00:00:00.000 -0800")
+	mock.ExpectQuery(`^SHOW MATERIALIZED VIEWS LIKE 'test_view' IN DATABASE "test_db"$`).WillReturnRows(rows)
+
+	q := NewMaterializedViewBuilder("test_view").WithDB("test_db").WithSchema("test_schema").Show()
+	v, err := ScanMaterializedView(QueryRow(mockDB, q))
+	r.NoError(err)
+	r.Equal("5m", v.BehindBy.String)
+	r.Equal("2023-01-01 00:00:00.000 -0800", v.RefreshedOn.String)
+	r.True(v.IsStale())
+
+	v.BehindBy.String = "0s"
+	r.False(v.IsStale())
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestMaterializedViewRestrictions(t *testing.T) {
+	r := require.New(t)
+
+	r.Empty(MaterializedViewRestrictions("SELECT id, count(*) FROM db.s.t WHERE note = 'a join b order by c' GROUP BY id"))
+	r.Empty(MaterializedViewRestrictions("SELECT id, name FROM db.s.t AS t WHERE id > 1"))
+	r.Equal([]string{"a join (a materialized view can only query a single table)"}, MaterializedViewRestrictions("SELECT a.id FROM a LEFT JOIN b ON a.id = b.id"))
+	r.Equal([]string{"a join (a materialized view can only query a single table)"}, MaterializedViewRestrictions("SELECT a.id FROM a x, b y WHERE x.id = y.id"))
+	r.Equal([]string{"a set operator (UNION, INTERSECT, EXCEPT, MINUS)"}, MaterializedViewRestrictions("SELECT id FROM a UNION ALL SELECT id FROM a"))
+	r.Equal(
+		[]string{"HAVING", "ORDER BY", "LIMIT, TOP or FETCH", "window function (OVER)", "non-deterministic function CURRENT_TIMESTAMP"},
+		MaterializedViewRestrictions("SELECT id, rank() over (order by id), current_timestamp() FROM t GROUP BY id HAVING count(*) > 1 ORDER BY id LIMIT 10"),
+	)
+}
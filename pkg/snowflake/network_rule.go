@@ -0,0 +1,48 @@
+package snowflake
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// NetworkRule is a row of the SHOW NETWORK RULES output.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/show-network-rules)
+type NetworkRule struct {
+	Name         sql.NullString `db:"name"`
+	DatabaseName sql.NullString `db:"database_name"`
+	SchemaName   sql.NullString `db:"schema_name"`
+	Type         sql.NullString `db:"type"`
+	Mode         sql.NullString `db:"mode"`
+	Comment      sql.NullString `db:"comment"`
+	Owner        sql.NullString `db:"owner"`
+}
+
+// ListNetworkRules returns the network rules in the given schema, optionally filtered by a LIKE pattern.
+func ListNetworkRules(databaseName string, schemaName string, pattern string, db *sql.DB) ([]NetworkRule, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW NETWORK RULES")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	stmt.WriteString(fmt.Sprintf(` IN SCHEMA "%v"."%v"`, databaseName, schemaName))
+	rows, err := Query(db, stmt.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbs := []NetworkRule{}
+	if err := sqlx.StructScan(rows, &dbs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Println("[DEBUG] no network rules found")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
+	}
+	return dbs, nil
+}
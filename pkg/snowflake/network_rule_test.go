@@ -0,0 +1,28 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListNetworkRules(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "owner", "comment", "type", "mode", "entries_in_value_list", "owner_role_type",
+	}).AddRow("", "test_rule", "test_db", "test_schema", "ACCOUNTADMIN", "great comment", "HOST_PORT", "EGRESS", 2, "ROLE")
+	mock.ExpectQuery(`^SHOW NETWORK RULES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	rules, err := ListNetworkRules("test_db", "test_schema", "test%", mockDB)
+	r.NoError(err)
+	r.Len(rules, 1)
+	r.Equal("test_rule", rules[0].Name.String)
+	r.Equal("HOST_PORT", rules[0].Type.String)
+	r.Equal("EGRESS", rules[0].Mode.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
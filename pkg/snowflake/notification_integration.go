@@ -2,6 +2,10 @@ package snowflake
 
 import (
 	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -29,6 +33,7 @@ type NotificationIntegration struct {
 	Type      sql.NullString `db:"type"`
 	CreatedOn sql.NullString `db:"created_on"`
 	Enabled   sql.NullBool   `db:"enabled"`
+	Comment   sql.NullString `db:"comment"`
 }
 
 func ScanNotificationIntegration(row *sqlx.Row) (*NotificationIntegration, error) {
@@ -36,3 +41,52 @@ func ScanNotificationIntegration(row *sqlx.Row) (*NotificationIntegration, error
 	err := row.StructScan(r)
 	return r, err
 }
+
+// ListNotificationIntegrations returns the notification integrations in the account, optionally
+// filtered by a LIKE pattern.
+func ListNotificationIntegrations(pattern string, db *sql.DB) ([]NotificationIntegration, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW NOTIFICATION INTEGRATIONS")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	rows, err := Query(db, stmt.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbs := []NotificationIntegration{}
+	if err := sqlx.StructScan(rows, &dbs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Println("[DEBUG] no notification integrations found")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
+	}
+	return dbs, nil
+}
+
+// DescribeNotificationIntegrationProvider returns the NOTIFICATION_PROVIDER property of the given
+// notification integration, which SHOW NOTIFICATION INTEGRATIONS does not report.
+func DescribeNotificationIntegrationProvider(name string, db *sql.DB) (string, error) {
+	stmt := NewNotificationIntegrationBuilder(name).Describe()
+	rows, err := Query(db, stmt)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	var k, pType string
+	var v, unused interface{}
+	provider := ""
+	for rows.Next() {
+		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
+			return "", err
+		}
+		if k == "NOTIFICATION_PROVIDER" {
+			provider, _ = v.(string)
+		}
+	}
+	return provider, rows.Err()
+}
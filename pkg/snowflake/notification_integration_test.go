@@ -3,6 +3,7 @@ package snowflake_test
 import (
 	"testing"
 
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/stretchr/testify/require"
 )
@@ -65,3 +66,42 @@ func TestNotificationIntegration_AWS_SNS(t *testing.T) {
 
 	r.Equal(`CREATE NOTIFICATION INTEGRATION "aws_sns" AWS_SNS_ROLE_ARN='some-iam-role-arn' AWS_SNS_TOPIC_ARN='some-sns-arn' DIRECTION='OUTBOUND' TYPE='QUEUE' ENABLED=true`, q)
 }
+
+func TestListNotificationIntegrations(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"name", "type", "category", "enabled", "comment", "created_on",
+	}).AddRow("TEST_NI", "QUEUE - AWS_SNS", "NOTIFICATION", true, "errors topic", "")
+	mock.ExpectQuery(`^SHOW NOTIFICATION INTEGRATIONS LIKE 'test%'$`).WillReturnRows(rows)
+
+	integrations, err := snowflake.ListNotificationIntegrations("test%", mockDB)
+	r.NoError(err)
+	r.Len(integrations, 1)
+	r.Equal("TEST_NI", integrations[0].Name.String)
+	r.Equal("QUEUE - AWS_SNS", integrations[0].Type.String)
+	r.True(integrations[0].Enabled.Bool)
+	r.Equal("errors topic", integrations[0].Comment.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDescribeNotificationIntegrationProvider(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{"property", "property_type", "property_value", "property_default"}).
+		AddRow("ENABLED", "Boolean", "true", "false").
+		AddRow("NOTIFICATION_PROVIDER", "String", "AWS_SNS", "").
+		AddRow("DIRECTION", "String", "OUTBOUND", "INBOUND")
+	mock.ExpectQuery(`^DESCRIBE NOTIFICATION INTEGRATION "TEST_NI"$`).WillReturnRows(rows)
+
+	provider, err := snowflake.DescribeNotificationIntegrationProvider("TEST_NI", mockDB)
+	r.NoError(err)
+	r.Equal("AWS_SNS", provider)
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -2,9 +2,7 @@ package snowflake
 
 import (
 	"database/sql"
-	"errors"
 	"fmt"
-	"log"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -43,26 +41,6 @@ func ScanOAuthIntegration(row *sqlx.Row) (*OauthIntegration, error) {
 	return r, nil
 }
 
-func ListIntegrations(db *sql.DB) ([]OauthIntegration, error) {
-	stmt := "SHOW INTEGRATIONS"
-	rows, err := db.Query(stmt)
-	if err != nil {
-		return nil, err
-	}
-
-	defer rows.Close()
-
-	r := []OauthIntegration{}
-	if err := sqlx.StructScan(rows, &r); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			log.Println("[DEBUG] no integrations found")
-			return nil, nil
-		}
-		return r, fmt.Errorf("failed to scan row for %s err = %w", stmt, err)
-	}
-	return r, nil
-}
-
 func DropIntegration(db *sql.DB, name string) error {
 	stmt := NewOAuthIntegrationBuilder(name).Drop()
 	return Exec(db, stmt)
@@ -29,6 +29,8 @@ from bar;`
 	commentEscape := `create view foo comment='asdf\'s are fun' as select * from bar;`
 	identifier := `create view "foo"."bar"."bam" comment='asdf\'s are fun' as select * from bar;`
 
+	udtf := `create secure view foo as select * from table(my_udtf('a', 1)) t;`
+
 	full := `CREATE SECURE VIEW "rgdxfmnfhh"."PUBLIC"."rgdxfmnfhh" COMMENT = 'Terraform test resource' AS SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.APPLICABLE_ROLES`
 
 	type args struct {
@@ -52,6 +54,7 @@ from bar;`
 		{"comment", args{comment}, "select * from bar;", false},
 		{"commentEscape", args{commentEscape}, "select * from bar;", false},
 		{"identifier", args{identifier}, "select * from bar;", false},
+		{"udtf", args{udtf}, "select * from table(my_udtf('a', 1)) t;", false},
 		{"full", args{full}, "SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.APPLICABLE_ROLES", false},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,46 @@
+package snowflake
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// PasswordPolicy is a row of the SHOW PASSWORD POLICIES output.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/show-password-policies)
+type PasswordPolicy struct {
+	Name         sql.NullString `db:"name"`
+	DatabaseName sql.NullString `db:"database_name"`
+	SchemaName   sql.NullString `db:"schema_name"`
+	Owner        sql.NullString `db:"owner"`
+	Comment      sql.NullString `db:"comment"`
+}
+
+// ListPasswordPolicies returns the password policies in the given schema, optionally filtered by a LIKE pattern.
+func ListPasswordPolicies(databaseName string, schemaName string, pattern string, db *sql.DB) ([]PasswordPolicy, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW PASSWORD POLICIES")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	stmt.WriteString(fmt.Sprintf(` IN SCHEMA "%v"."%v"`, databaseName, schemaName))
+	rows, err := Query(db, stmt.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbs := []PasswordPolicy{}
+	if err := sqlx.StructScan(rows, &dbs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Println("[DEBUG] no password policies found")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
+	}
+	return dbs, nil
+}
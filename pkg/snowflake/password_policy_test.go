@@ -0,0 +1,29 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPasswordPolicies(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "kind", "owner", "comment", "options",
+	}).AddRow("", "test_policy", "test_db", "test_schema", "PASSWORD_POLICY", "ACCOUNTADMIN", "great comment", "")
+	mock.ExpectQuery(`^SHOW PASSWORD POLICIES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	policies, err := ListPasswordPolicies("test_db", "test_schema", "test%", mockDB)
+	r.NoError(err)
+	r.Len(policies, 1)
+	r.Equal("test_policy", policies[0].Name.String)
+	r.Equal("test_db", policies[0].DatabaseName.String)
+	r.Equal("ACCOUNTADMIN", policies[0].Owner.String)
+	r.Equal("great comment", policies[0].Comment.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,63 @@
+package snowflake
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PolicyAttachmentBuilder abstracts the creation of SQL queries setting a policy of a given kind
+// (SESSION, AUTHENTICATION, PASSWORD...) on a user, a role or the account and unsetting it.
+type PolicyAttachmentBuilder struct {
+	policyKind string
+	objectType string
+	objectName string
+	policy     string
+}
+
+// PolicyAttachment returns a pointer to a Builder for the policy of policyKind of the object of
+// objectType (USER, ROLE or ACCOUNT) named name. The name is ignored for the ACCOUNT, which is
+// always the current one.
+func PolicyAttachment(policyKind, objectType, name string) *PolicyAttachmentBuilder {
+	return &PolicyAttachmentBuilder{
+		policyKind: policyKind,
+		objectType: objectType,
+		objectName: name,
+	}
+}
+
+// WithPolicy sets the fully qualified name of the policy.
+func (b *PolicyAttachmentBuilder) WithPolicy(qualifiedName string) *PolicyAttachmentBuilder {
+	b.policy = qualifiedName
+	return b
+}
+
+func (b *PolicyAttachmentBuilder) alter() string {
+	if b.objectType == "ACCOUNT" {
+		return `ALTER ACCOUNT`
+	}
+	return fmt.Sprintf(`ALTER %v "%v"`, b.objectType, b.objectName)
+}
+
+// Set returns the SQL query that will set the policy on the object.
+func (b *PolicyAttachmentBuilder) Set() string {
+	return fmt.Sprintf(`%v SET %v POLICY %v`, b.alter(), b.policyKind, b.policy)
+}
+
+// Unset returns the SQL query that will unset the policy of the object.
+func (b *PolicyAttachmentBuilder) Unset() string {
+	return fmt.Sprintf(`%v UNSET %v POLICY`, b.alter(), b.policyKind)
+}
+
+// PolicyKind returns the POLICY_KIND reported by POLICY_REFERENCES for the policies of the builder.
+func (b *PolicyAttachmentBuilder) PolicyKind() string {
+	return strings.ReplaceAll(b.policyKind, " ", "_") + "_POLICY"
+}
+
+// Show returns the SQL query that will list the policies attached to the object, using the
+// information schema of database db.
+func (b *PolicyAttachmentBuilder) Show(db string) string {
+	if b.objectType == "ACCOUNT" {
+		return fmt.Sprintf(`SELECT * FROM TABLE("%v".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => CURRENT_ACCOUNT(), REF_ENTITY_DOMAIN => 'ACCOUNT'))`, db)
+	}
+	return PolicyReferences(db, fmt.Sprintf(`"%v"`, b.objectName), b.objectType)
+}
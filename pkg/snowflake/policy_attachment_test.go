@@ -0,0 +1,27 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyAttachment(t *testing.T) {
+	r := require.New(t)
+	b := PolicyAttachment("SESSION", "USER", "user").WithPolicy(`"db"."schema"."policy"`)
+
+	r.Equal(`ALTER USER "user" SET SESSION POLICY "db"."schema"."policy"`, b.Set())
+	r.Equal(`ALTER USER "user" UNSET SESSION POLICY`, b.Unset())
+	r.Equal(`SESSION_POLICY`, b.PolicyKind())
+	r.Equal(`SELECT * FROM TABLE("db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"user"', REF_ENTITY_DOMAIN => 'USER'))`, b.Show("db"))
+}
+
+func TestPolicyAttachmentAccount(t *testing.T) {
+	r := require.New(t)
+	b := PolicyAttachment("AUTHENTICATION", "ACCOUNT", "").WithPolicy(`"db"."schema"."policy"`)
+
+	r.Equal(`ALTER ACCOUNT SET AUTHENTICATION POLICY "db"."schema"."policy"`, b.Set())
+	r.Equal(`ALTER ACCOUNT UNSET AUTHENTICATION POLICY`, b.Unset())
+	r.Equal(`AUTHENTICATION_POLICY`, b.PolicyKind())
+	r.Equal(`SELECT * FROM TABLE("db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => CURRENT_ACCOUNT(), REF_ENTITY_DOMAIN => 'ACCOUNT'))`, b.Show("db"))
+}
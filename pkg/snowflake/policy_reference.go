@@ -0,0 +1,66 @@
+package snowflake
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// PolicyReference is a row of the INFORMATION_SCHEMA.POLICY_REFERENCES table function, which lists
+// the policies attached to an object.
+type PolicyReference struct {
+	PolicyDB          sql.NullString `db:"POLICY_DB"`
+	PolicySchema      sql.NullString `db:"POLICY_SCHEMA"`
+	PolicyName        sql.NullString `db:"POLICY_NAME"`
+	PolicyKind        sql.NullString `db:"POLICY_KIND"`
+	RefDatabaseName   sql.NullString `db:"REF_DATABASE_NAME"`
+	RefSchemaName     sql.NullString `db:"REF_SCHEMA_NAME"`
+	RefEntityName     sql.NullString `db:"REF_ENTITY_NAME"`
+	RefEntityDomain   sql.NullString `db:"REF_ENTITY_DOMAIN"`
+	RefColumnName     sql.NullString `db:"REF_COLUMN_NAME"`
+	RefArgColumnNames sql.NullString `db:"REF_ARG_COLUMN_NAMES"`
+}
+
+// ArgColumnNames returns the columns passed to the policy, which POLICY_REFERENCES reports as a
+// JSON array.
+func (pr *PolicyReference) ArgColumnNames() []string {
+	columns := []string{}
+	if !pr.RefArgColumnNames.Valid || pr.RefArgColumnNames.String == "" {
+		return columns
+	}
+	if err := json.Unmarshal([]byte(pr.RefArgColumnNames.String), &columns); err != nil {
+		log.Printf("[WARN] unable to parse REF_ARG_COLUMN_NAMES %v: %v", pr.RefArgColumnNames.String, err)
+		return []string{}
+	}
+	return columns
+}
+
+// PolicyReferences returns the SQL query that lists the policies attached to the object named
+// qualifiedName of the given domain (TABLE, VIEW, USER, ACCOUNT...), using the information schema
+// of database db.
+func PolicyReferences(db, qualifiedName, domain string) string {
+	return fmt.Sprintf(`SELECT * FROM TABLE("%v".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '%v', REF_ENTITY_DOMAIN => '%v'))`, db, EscapeString(qualifiedName), domain)
+}
+
+// ListPolicyReferences runs stmt, as returned by PolicyReferences, and scans its rows.
+func ListPolicyReferences(stmt string, db *sql.DB) ([]PolicyReference, error) {
+	rows, err := Query(db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	references := []PolicyReference{}
+	if err := sqlx.StructScan(rows, &references); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Println("[DEBUG] no policy references found")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+	}
+	return references, nil
+}
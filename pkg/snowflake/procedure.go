@@ -277,9 +277,14 @@ func ScanProcedures(rows *sqlx.Rows) ([]*Procedure, error) {
 	return pcs, rows.Err()
 }
 
-func ListProcedures(databaseName string, schemaName string, db *sql.DB) ([]Procedure, error) {
-	stmt := fmt.Sprintf(`SHOW PROCEDURES IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListProcedures(databaseName string, schemaName string, pattern string, db *sql.DB) ([]Procedure, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW PROCEDURES")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	stmt.WriteString(fmt.Sprintf(` IN SCHEMA "%s"."%v"`, databaseName, schemaName))
+	rows, err := Query(db, stmt.String())
 	if err != nil {
 		return nil, err
 	}
@@ -291,7 +296,7 @@ func ListProcedures(databaseName string, schemaName string, db *sql.DB) ([]Proce
 			log.Println("[DEBUG] no procedures found")
 			return nil, nil
 		}
-		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
 	}
 	return dbs, nil
 }
@@ -3,6 +3,7 @@ package snowflake
 import (
 	"testing"
 
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -131,3 +132,23 @@ func TestProcedureArgumentsSignature(t *testing.T) {
 	sign, _ = s.ArgumentsSignature()
 	r.Equal("TEST_PROC(VARCHAR, DATE)", sign)
 }
+
+func TestListProcedures(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "schema_name", "is_builtin", "is_aggregate", "is_ansi", "min_num_arguments", "max_num_arguments", "arguments", "description", "catalog_name", "is_table_function", "valid_for_clustering", "is_secure",
+	}).AddRow("", "TEST_PROC", "test_schema", "N", "N", "N", 1, 1, "TEST_PROC(VARCHAR) RETURN VARCHAR", "user-defined procedure", "test_db", "N", "N", "N")
+	mock.ExpectQuery(`^SHOW PROCEDURES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	procedures, err := ListProcedures("test_db", "test_schema", "test%", mockDB)
+	r.NoError(err)
+	r.Len(procedures, 1)
+	r.Equal("TEST_PROC", procedures[0].Name.String)
+	r.Equal("test_db", procedures[0].DatabaseName.String)
+	r.Equal("TEST_PROC(VARCHAR) RETURN VARCHAR", procedures[0].Arguments.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,54 @@
+package snowflake
+
+import (
+	"fmt"
+)
+
+// ProjectionPolicyAttachmentBuilder abstracts the creation of SQL queries setting a projection policy
+// on a column of a table or view and unsetting it.
+type ProjectionPolicyAttachmentBuilder struct {
+	entityType   string
+	entityDB     string
+	entitySchema string
+	entityName   string
+	column       string
+	policy       string
+}
+
+// ProjectionPolicyAttachment returns a pointer to a Builder for the projection policy of the column
+// of the object of entityType (TABLE or VIEW) named name.
+func ProjectionPolicyAttachment(entityType, db, schema, name, column string) *ProjectionPolicyAttachmentBuilder {
+	return &ProjectionPolicyAttachmentBuilder{
+		entityType:   entityType,
+		entityDB:     db,
+		entitySchema: schema,
+		entityName:   name,
+		column:       column,
+	}
+}
+
+// WithPolicy sets the fully qualified name of the projection policy.
+func (b *ProjectionPolicyAttachmentBuilder) WithPolicy(qualifiedName string) *ProjectionPolicyAttachmentBuilder {
+	b.policy = qualifiedName
+	return b
+}
+
+// QualifiedName returns the escaped name of the table or view.
+func (b *ProjectionPolicyAttachmentBuilder) QualifiedName() string {
+	return fmt.Sprintf(`"%v"."%v"."%v"`, b.entityDB, b.entitySchema, b.entityName)
+}
+
+// Set returns the SQL query that will set the projection policy on the column.
+func (b *ProjectionPolicyAttachmentBuilder) Set() string {
+	return fmt.Sprintf(`ALTER %v %v ALTER COLUMN "%v" SET PROJECTION POLICY %v`, b.entityType, b.QualifiedName(), b.column, b.policy)
+}
+
+// Unset returns the SQL query that will unset the projection policy of the column.
+func (b *ProjectionPolicyAttachmentBuilder) Unset() string {
+	return fmt.Sprintf(`ALTER %v %v ALTER COLUMN "%v" UNSET PROJECTION POLICY`, b.entityType, b.QualifiedName(), b.column)
+}
+
+// Show returns the SQL query that will list the policies attached to the table or view.
+func (b *ProjectionPolicyAttachmentBuilder) Show() string {
+	return PolicyReferences(b.entityDB, b.QualifiedName(), b.entityType)
+}
@@ -0,0 +1,17 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectionPolicyAttachment(t *testing.T) {
+	r := require.New(t)
+	b := ProjectionPolicyAttachment("VIEW", "db", "schema", "view", "email").
+		WithPolicy(`"pp_db"."pp_schema"."pp_name"`)
+
+	r.Equal(`ALTER VIEW "db"."schema"."view" ALTER COLUMN "email" SET PROJECTION POLICY "pp_db"."pp_schema"."pp_name"`, b.Set())
+	r.Equal(`ALTER VIEW "db"."schema"."view" ALTER COLUMN "email" UNSET PROJECTION POLICY`, b.Unset())
+	r.Equal(`SELECT * FROM TABLE("db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"db"."schema"."view"', REF_ENTITY_DOMAIN => 'VIEW'))`, b.Show())
+}
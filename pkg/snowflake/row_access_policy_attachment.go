@@ -0,0 +1,64 @@
+package snowflake
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RowAccessPolicyAttachmentBuilder abstracts the creation of SQL queries adding a row access policy
+// to a table or view and dropping it.
+type RowAccessPolicyAttachmentBuilder struct {
+	objectType   string
+	objectDB     string
+	objectSchema string
+	objectName   string
+	policy       string
+	columns      []string
+}
+
+// RowAccessPolicyAttachment returns a pointer to a Builder for the row access policy of the object
+// of objectType (TABLE or VIEW) named name.
+func RowAccessPolicyAttachment(objectType, db, schema, name string) *RowAccessPolicyAttachmentBuilder {
+	return &RowAccessPolicyAttachmentBuilder{
+		objectType:   objectType,
+		objectDB:     db,
+		objectSchema: schema,
+		objectName:   name,
+	}
+}
+
+// WithPolicy sets the fully qualified name of the row access policy.
+func (b *RowAccessPolicyAttachmentBuilder) WithPolicy(qualifiedName string) *RowAccessPolicyAttachmentBuilder {
+	b.policy = qualifiedName
+	return b
+}
+
+// WithColumns sets the columns of the object passed to the policy.
+func (b *RowAccessPolicyAttachmentBuilder) WithColumns(columns []string) *RowAccessPolicyAttachmentBuilder {
+	b.columns = columns
+	return b
+}
+
+// QualifiedName returns the escaped name of the object the policy is attached to.
+func (b *RowAccessPolicyAttachmentBuilder) QualifiedName() string {
+	return fmt.Sprintf(`"%v"."%v"."%v"`, b.objectDB, b.objectSchema, b.objectName)
+}
+
+// Add returns the SQL query that will add the row access policy to the object.
+func (b *RowAccessPolicyAttachmentBuilder) Add() string {
+	columns := make([]string, 0, len(b.columns))
+	for _, c := range b.columns {
+		columns = append(columns, fmt.Sprintf(`"%v"`, c))
+	}
+	return fmt.Sprintf(`ALTER %v %v ADD ROW ACCESS POLICY %v ON (%v)`, b.objectType, b.QualifiedName(), b.policy, strings.Join(columns, ", "))
+}
+
+// Drop returns the SQL query that will drop the row access policy from the object.
+func (b *RowAccessPolicyAttachmentBuilder) Drop() string {
+	return fmt.Sprintf(`ALTER %v %v DROP ROW ACCESS POLICY %v`, b.objectType, b.QualifiedName(), b.policy)
+}
+
+// Show returns the SQL query that will list the policies attached to the object.
+func (b *RowAccessPolicyAttachmentBuilder) Show() string {
+	return PolicyReferences(b.objectDB, b.QualifiedName(), b.objectType)
+}
@@ -0,0 +1,29 @@
+package snowflake
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowAccessPolicyAttachment(t *testing.T) {
+	r := require.New(t)
+	b := RowAccessPolicyAttachment("TABLE", "db", "schema", "table").
+		WithPolicy(`"pdb"."pschema"."policy"`).
+		WithColumns([]string{"region", "ID"})
+
+	r.Equal(`ALTER TABLE "db"."schema"."table" ADD ROW ACCESS POLICY "pdb"."pschema"."policy" ON ("region", "ID")`, b.Add())
+	r.Equal(`ALTER TABLE "db"."schema"."table" DROP ROW ACCESS POLICY "pdb"."pschema"."policy"`, b.Drop())
+	r.Equal(`SELECT * FROM TABLE("db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"db"."schema"."table"', REF_ENTITY_DOMAIN => 'TABLE'))`, b.Show())
+}
+
+func TestPolicyReferenceArgColumnNames(t *testing.T) {
+	r := require.New(t)
+
+	pr := PolicyReference{RefArgColumnNames: sql.NullString{String: `[ "REGION", "ID" ]`, Valid: true}}
+	r.Equal([]string{"REGION", "ID"}, pr.ArgColumnNames())
+
+	pr = PolicyReference{}
+	r.Empty(pr.ArgColumnNames())
+}
@@ -0,0 +1,28 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSecrets(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "schema_name", "database_name", "owner", "comment", "secret_type", "oauth_scopes", "owner_role_type",
+	}).AddRow("", "test_secret", "test_schema", "test_db", "ACCOUNTADMIN", "great comment", "PASSWORD", "", "ROLE")
+	mock.ExpectQuery(`^SHOW SECRETS LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	secrets, err := ListSecrets("test_db", "test_schema", "test%", mockDB)
+	r.NoError(err)
+	r.Len(secrets, 1)
+	r.Equal("test_secret", secrets[0].Name.String)
+	r.Equal("PASSWORD", secrets[0].SecretType.String)
+	r.Equal("ACCOUNTADMIN", secrets[0].Owner.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -30,6 +30,7 @@ type Sequence struct {
 	CreatedOn  sql.NullString `db:"created_on"`
 	Owner      sql.NullString `db:"owner"`
 	Comment    sql.NullString `db:"comment"`
+	Ordered    sql.NullString `db:"ordered"`
 }
 
 type SequenceBuilder struct {
@@ -39,6 +40,7 @@ type SequenceBuilder struct {
 	increment int
 	comment   string
 	start     int
+	ordering  string
 }
 
 // Drop returns the SQL query that will drop a sequence.
@@ -60,6 +62,9 @@ func (sb *SequenceBuilder) Create() string {
 	if sb.increment != 1 {
 		q.WriteString(fmt.Sprintf(` INCREMENT = %d`, sb.increment))
 	}
+	if sb.ordering != "" {
+		q.WriteString(fmt.Sprintf(` %v`, sb.ordering))
+	}
 	if sb.comment != "" {
 		q.WriteString(fmt.Sprintf(` COMMENT = '%v'`, EscapeString(sb.comment)))
 	}
@@ -76,6 +81,13 @@ func (sb *SequenceBuilder) WithIncrement(increment int) *SequenceBuilder {
 	return sb
 }
 
+// WithOrdering sets whether the sequence generates values in increasing order (ORDER) or not
+// (NOORDER).
+func (sb *SequenceBuilder) WithOrdering(ordering string) *SequenceBuilder {
+	sb.ordering = strings.ToUpper(ordering)
+	return sb
+}
+
 func (sb *SequenceBuilder) WithStart(start int) *SequenceBuilder {
 	sb.start = start
 	return sb
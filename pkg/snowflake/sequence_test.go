@@ -20,6 +20,10 @@ func TestSequenceCreate(t *testing.T) {
 	r.Equal(`CREATE SEQUENCE "test_db"."test_schema"."test_sequence" INCREMENT = 5 COMMENT = 'Test Comment'`, s.Create())
 	s.WithStart(26)
 	r.Equal(`CREATE SEQUENCE "test_db"."test_schema"."test_sequence" START = 26 INCREMENT = 5 COMMENT = 'Test Comment'`, s.Create())
+	s.WithOrdering("order")
+	r.Equal(`CREATE SEQUENCE "test_db"."test_schema"."test_sequence" START = 26 INCREMENT = 5 ORDER COMMENT = 'Test Comment'`, s.Create())
+	s.WithOrdering("NOORDER")
+	r.Equal(`CREATE SEQUENCE "test_db"."test_schema"."test_sequence" START = 26 INCREMENT = 5 NOORDER COMMENT = 'Test Comment'`, s.Create())
 }
 
 func TestSequenceDrop(t *testing.T) {
@@ -0,0 +1,51 @@
+package snowflake
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Service is a row of the SHOW SERVICES output.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/show-services)
+type Service struct {
+	Name             sql.NullString `db:"name"`
+	DatabaseName     sql.NullString `db:"database_name"`
+	SchemaName       sql.NullString `db:"schema_name"`
+	ComputePool      sql.NullString `db:"compute_pool"`
+	DNSName          sql.NullString `db:"dns_name"`
+	CurrentInstances sql.NullInt64  `db:"current_instances"`
+	TargetInstances  sql.NullInt64  `db:"target_instances"`
+	Status           sql.NullString `db:"status"`
+	Owner            sql.NullString `db:"owner"`
+	Comment          sql.NullString `db:"comment"`
+}
+
+// ListServices returns the services in the given schema, optionally filtered by a LIKE pattern.
+func ListServices(databaseName string, schemaName string, pattern string, db *sql.DB) ([]Service, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW SERVICES")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	stmt.WriteString(fmt.Sprintf(` IN SCHEMA "%v"."%v"`, databaseName, schemaName))
+	rows, err := Query(db, stmt.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbs := []Service{}
+	if err := sqlx.StructScan(rows, &dbs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Println("[DEBUG] no services found")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
+	}
+	return dbs, nil
+}
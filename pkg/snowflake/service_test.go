@@ -0,0 +1,30 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListServices(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "owner", "compute_pool", "dns_name", "current_instances", "target_instances", "status", "comment",
+	}).AddRow("", "test_service", "test_db", "test_schema", "ACCOUNTADMIN", "TEST_POOL", "test-service.test-schema.test-db.snowflakecomputing.internal", 1, 2, "RUNNING", "great comment")
+	mock.ExpectQuery(`^SHOW SERVICES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	services, err := ListServices("test_db", "test_schema", "test%", mockDB)
+	r.NoError(err)
+	r.Len(services, 1)
+	r.Equal("test_service", services[0].Name.String)
+	r.Equal("TEST_POOL", services[0].ComputePool.String)
+	r.Equal(int64(1), services[0].CurrentInstances.Int64)
+	r.Equal(int64(2), services[0].TargetInstances.Int64)
+	r.Equal("RUNNING", services[0].Status.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,60 @@
+package snowflake
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// SessionPolicy is a row of the SHOW SESSION POLICIES output.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/show-session-policies)
+type SessionPolicy struct {
+	Name         sql.NullString `db:"name"`
+	DatabaseName sql.NullString `db:"database_name"`
+	SchemaName   sql.NullString `db:"schema_name"`
+	Owner        sql.NullString `db:"owner"`
+	Comment      sql.NullString `db:"comment"`
+}
+
+// ListSessionPolicies returns the session policies in the given schema, optionally filtered by a LIKE pattern.
+func ListSessionPolicies(databaseName string, schemaName string, pattern string, db *sql.DB) ([]SessionPolicy, error) {
+	stmt := strings.Builder{}
+	stmt.WriteString("SHOW SESSION POLICIES")
+	if pattern != "" {
+		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern)))
+	}
+	stmt.WriteString(fmt.Sprintf(` IN SCHEMA "%v"."%v"`, databaseName, schemaName))
+	rows, err := Query(db, stmt.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbs := []SessionPolicy{}
+	if err := sqlx.StructScan(rows, &dbs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Println("[DEBUG] no session policies found")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt.String(), err)
+	}
+	return dbs, nil
+}
+
+// DescribeSessionPolicyIdleTimeout returns the SESSION_IDLE_TIMEOUT_MINS property of the given
+// session policy, which SHOW SESSION POLICIES does not report.
+func DescribeSessionPolicyIdleTimeout(databaseName string, schemaName string, name string, db *sql.DB) (int64, error) {
+	stmt := fmt.Sprintf(`DESCRIBE SESSION POLICY "%v"."%v"."%v"`, databaseName, schemaName, name)
+	row := QueryRow(db, stmt)
+	policy := struct {
+		SessionIdleTimeoutMins sql.NullInt64 `db:"session_idle_timeout_mins"`
+	}{}
+	if err := row.StructScan(&policy); err != nil {
+		return 0, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+	}
+	return policy.SessionIdleTimeoutMins.Int64, nil
+}
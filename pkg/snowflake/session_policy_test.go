@@ -0,0 +1,45 @@
+package snowflake
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSessionPolicies(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "kind", "owner", "comment", "options",
+	}).AddRow("", "test_policy", "test_db", "test_schema", "SESSION_POLICY", "ACCOUNTADMIN", "great comment", "")
+	mock.ExpectQuery(`^SHOW SESSION POLICIES LIKE 'test%' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	policies, err := ListSessionPolicies("test_db", "test_schema", "test%", mockDB)
+	r.NoError(err)
+	r.Len(policies, 1)
+	r.Equal("test_policy", policies[0].Name.String)
+	r.Equal("ACCOUNTADMIN", policies[0].Owner.String)
+	r.Equal("great comment", policies[0].Comment.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDescribeSessionPolicyIdleTimeout(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "session_idle_timeout_mins", "session_ui_idle_timeout_mins", "comment",
+	}).AddRow("", "test_policy", 60, 240, "great comment")
+	mock.ExpectQuery(`^DESCRIBE SESSION POLICY "test_db"."test_schema"."test_policy"$`).WillReturnRows(rows)
+
+	timeout, err := DescribeSessionPolicyIdleTimeout("test_db", "test_schema", "test_policy", mockDB)
+	r.NoError(err)
+	r.Equal(int64(60), timeout)
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -41,8 +42,11 @@ func ScanStorageIntegration(row *sqlx.Row) (*StorageIntegration, error) {
 	return r, err
 }
 
-func ListStorageIntegrations(db *sql.DB) ([]StorageIntegration, error) {
+func ListStorageIntegrations(pattern string, db *sql.DB) ([]StorageIntegration, error) {
 	stmt := "SHOW STORAGE INTEGRATIONS"
+	if pattern != "" {
+		stmt += fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern))
+	}
 	rows, err := Query(db, stmt)
 	if err != nil {
 		return nil, err
@@ -59,3 +63,54 @@ func ListStorageIntegrations(db *sql.DB) ([]StorageIntegration, error) {
 	}
 	return dbs, nil
 }
+
+// StorageIntegrationLocations holds the storage provider and locations of a storage integration,
+// which SHOW STORAGE INTEGRATIONS does not report.
+type StorageIntegrationLocations struct {
+	StorageProvider         string
+	StorageAllowedLocations []string
+	StorageBlockedLocations []string
+}
+
+// DescribeStorageIntegrationLocations returns the storage provider and the allowed and blocked
+// locations of the given storage integration.
+func DescribeStorageIntegrationLocations(name string, db *sql.DB) (*StorageIntegrationLocations, error) {
+	stmt := NewStorageIntegrationBuilder(name).Describe()
+	rows, err := Query(db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var k, pType string
+	var v, unused interface{}
+	locations := &StorageIntegrationLocations{
+		StorageAllowedLocations: []string{},
+		StorageBlockedLocations: []string{},
+	}
+	for rows.Next() {
+		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
+			return nil, err
+		}
+		value, _ := v.(string)
+		switch k {
+		case "STORAGE_PROVIDER":
+			locations.StorageProvider = value
+		case "STORAGE_ALLOWED_LOCATIONS":
+			locations.StorageAllowedLocations = splitLocations(value)
+		case "STORAGE_BLOCKED_LOCATIONS":
+			locations.StorageBlockedLocations = splitLocations(value)
+		}
+	}
+	return locations, rows.Err()
+}
+
+func splitLocations(value string) []string {
+	locations := []string{}
+	for _, location := range strings.Split(value, ",") {
+		if location = strings.TrimSpace(location); location != "" {
+			locations = append(locations, location)
+		}
+	}
+	return locations
+}
@@ -3,6 +3,7 @@ package snowflake_test
 import (
 	"testing"
 
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/stretchr/testify/require"
 )
@@ -25,3 +26,41 @@ func TestStorageIntegration(t *testing.T) {
 
 	r.Equal(`CREATE STORAGE INTEGRATION "aws" STORAGE_AWS_OBJECT_ACL='bucket-owner-full-control' TYPE='EXTERNAL_STAGE' STORAGE_ALLOWED_LOCATIONS=('s3://my-bucket/my-path/', 's3://another-bucket/') ENABLED=true`, q)
 }
+
+func TestListStorageIntegrations(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{"name", "type", "category", "enabled", "comment", "created_on"}).
+		AddRow("S3_INTEGRATION", "EXTERNAL_STAGE", "STORAGE", true, "", "")
+	mock.ExpectQuery(`^SHOW STORAGE INTEGRATIONS LIKE 'S3%'$`).WillReturnRows(rows)
+
+	integrations, err := snowflake.ListStorageIntegrations("S3%", mockDB)
+	r.NoError(err)
+	r.Len(integrations, 1)
+	r.Equal("S3_INTEGRATION", integrations[0].Name.String)
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDescribeStorageIntegrationLocations(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{"property", "property_type", "property_value", "property_default"}).
+		AddRow("ENABLED", "Boolean", "true", "false").
+		AddRow("STORAGE_PROVIDER", "String", "S3", "").
+		AddRow("STORAGE_ALLOWED_LOCATIONS", "List", "s3://my-bucket/my-path/,s3://another-bucket/", "[]").
+		AddRow("STORAGE_BLOCKED_LOCATIONS", "List", "", "[]")
+	mock.ExpectQuery(`^DESCRIBE STORAGE INTEGRATION "S3_INTEGRATION"$`).WillReturnRows(rows)
+
+	locations, err := snowflake.DescribeStorageIntegrationLocations("S3_INTEGRATION", mockDB)
+	r.NoError(err)
+	r.Equal("S3", locations.StorageProvider)
+	r.Equal([]string{"s3://my-bucket/my-path/", "s3://another-bucket/"}, locations.StorageAllowedLocations)
+	r.Equal([]string{}, locations.StorageBlockedLocations)
+	r.NoError(mock.ExpectationsWereMet())
+}
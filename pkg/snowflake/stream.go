@@ -154,11 +154,12 @@ type DescStreamRow struct {
 	SchemaName      sql.NullString `db:"schema_name"`
 	Owner           sql.NullString `db:"owner"`
 	Comment         sql.NullString `db:"comment"`
-	ShowInitialRows bool           `db:"show_initial_rows"`
+	ShowInitialRows sql.NullBool   `db:"show_initial_rows"`
 	TableName       sql.NullString `db:"table_name"`
 	ViewName        sql.NullString `db:"view_name"`
 	Type            sql.NullString `db:"type"`
 	Stale           sql.NullString `db:"stale"`
+	StaleAfter      sql.NullString `db:"stale_after"`
 	Mode            sql.NullString `db:"mode"`
 }
 
@@ -215,7 +215,16 @@ func ScanTagPolicy(row *sqlx.Row) (*TagPolicyAttachment, error) {
 
 // ListTags returns a list of tags in a database or schema.
 func ListTags(databaseName, schemaName string, db *sql.DB) ([]Tag, error) {
-	stmt := fmt.Sprintf(`SHOW TAGS IN SCHEMA "%v"."%v"`, databaseName, schemaName)
+	return listTags(fmt.Sprintf(`SHOW TAGS IN SCHEMA "%v"."%v"`, databaseName, schemaName), db)
+}
+
+// ListTagsLike returns the tags whose name is like name in the database, or in the whole account
+// when databaseName is empty.
+func ListTagsLike(name, databaseName string, db *sql.DB) ([]Tag, error) {
+	return listTags(NewTagBuilder(name).WithDB(databaseName).Show(), db)
+}
+
+func listTags(stmt string, db *sql.DB) ([]Tag, error) {
 	rows, err := Query(db, stmt)
 	if err != nil {
 		return nil, err
@@ -139,3 +139,55 @@ func ListTagAssociations(tb *TagAssociationBuilder, db *sql.DB) ([]TagAssociatio
 
 	return tagAssociations, nil
 }
+
+// TagReference is a row of SNOWFLAKE.ACCOUNT_USAGE.TAG_REFERENCES describing an object, or a
+// column of one, that a tag is set on.
+type TagReference struct {
+	ObjectDatabase sql.NullString `db:"object_database"`
+	ObjectSchema   sql.NullString `db:"object_schema"`
+	ObjectName     sql.NullString `db:"object_name"`
+	ObjectID       sql.NullInt64  `db:"object_id"`
+	Domain         sql.NullString `db:"domain"`
+	ColumnName     sql.NullString `db:"column_name"`
+	TagValue       sql.NullString `db:"tag_value"`
+}
+
+// TagReferences returns the SQL query that will list the objects the tag is set on, optionally
+// only the ones of the given object types (domains such as TABLE or COLUMN). It reads
+// SNOWFLAKE.ACCOUNT_USAGE, which requires IMPORTED PRIVILEGES on the SNOWFLAKE database and lags
+// behind tagging by up to two hours.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/account-usage/tag_references.html)
+func (tb *TagAssociationBuilder) TagReferences(objectTypes []string) string {
+	var q strings.Builder
+	q.WriteString(fmt.Sprintf(`SELECT OBJECT_DATABASE AS "object_database", OBJECT_SCHEMA AS "object_schema", OBJECT_NAME AS "object_name", OBJECT_ID AS "object_id", DOMAIN AS "domain", COLUMN_NAME AS "column_name", TAG_VALUE AS "tag_value" FROM SNOWFLAKE.ACCOUNT_USAGE.TAG_REFERENCES WHERE TAG_DATABASE = '%v' AND TAG_SCHEMA = '%v' AND TAG_NAME = '%v' AND OBJECT_DELETED IS NULL`,
+		EscapeString(tb.databaseName), EscapeString(tb.schemaName), EscapeString(tb.tagName)))
+	if len(objectTypes) > 0 {
+		domains := make([]string, 0, len(objectTypes))
+		for _, objectType := range objectTypes {
+			domains = append(domains, fmt.Sprintf(`'%v'`, EscapeString(strings.ToUpper(objectType))))
+		}
+		q.WriteString(fmt.Sprintf(` AND DOMAIN IN (%v)`, strings.Join(domains, ", ")))
+	}
+	q.WriteString(` ORDER BY OBJECT_DATABASE, OBJECT_SCHEMA, OBJECT_NAME, COLUMN_NAME`)
+	return q.String()
+}
+
+// ListTagReferences returns the objects the tag built by tb is set on.
+func ListTagReferences(tb *TagAssociationBuilder, objectTypes []string, db *sql.DB) ([]TagReference, error) {
+	stmt := tb.TagReferences(objectTypes)
+	rows, err := Query(db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	references := []TagReference{}
+	if err := sqlx.StructScan(rows, &references); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Printf("[DEBUG] no references found for tag %s", tb.tagName)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+	}
+	return references, nil
+}
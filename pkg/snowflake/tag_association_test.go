@@ -1,8 +1,11 @@
 package snowflake
 
 import (
+	"regexp"
 	"testing"
 
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -59,3 +62,30 @@ func TestTableColumnName(t *testing.T) {
 		r.Equal(testCase.expectedColumnName, columnName)
 	}
 }
+
+func TestTagAssociationTagReferences(t *testing.T) {
+	r := require.New(t)
+	tb := NewTagAssociationBuilder(`"test_db"."test_schema"."cost_center"`)
+	r.Equal(`SELECT OBJECT_DATABASE AS "object_database", OBJECT_SCHEMA AS "object_schema", OBJECT_NAME AS "object_name", OBJECT_ID AS "object_id", DOMAIN AS "domain", COLUMN_NAME AS "column_name", TAG_VALUE AS "tag_value" FROM SNOWFLAKE.ACCOUNT_USAGE.TAG_REFERENCES WHERE TAG_DATABASE = 'test_db' AND TAG_SCHEMA = 'test_schema' AND TAG_NAME = 'cost_center' AND OBJECT_DELETED IS NULL ORDER BY OBJECT_DATABASE, OBJECT_SCHEMA, OBJECT_NAME, COLUMN_NAME`, tb.TagReferences(nil))
+	r.Contains(tb.TagReferences([]string{"table", "COLUMN"}), `AND OBJECT_DELETED IS NULL AND DOMAIN IN ('TABLE', 'COLUMN') ORDER BY`)
+}
+
+func TestListTagReferences(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+
+	rows := sqlmock.NewRows([]string{"object_database", "object_schema", "object_name", "object_id", "domain", "column_name", "tag_value"}).
+		AddRow("test_db", "test_schema", "orders", 42, "COLUMN", "amount", "finance")
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM SNOWFLAKE.ACCOUNT_USAGE.TAG_REFERENCES WHERE TAG_DATABASE = 'test_db' AND TAG_SCHEMA = 'test_schema' AND TAG_NAME = 'cost_center' AND OBJECT_DELETED IS NULL AND DOMAIN IN ('COLUMN')`)).WillReturnRows(rows)
+
+	references, err := ListTagReferences(NewTagAssociationBuilder("test_db|test_schema|cost_center"), []string{"column"}, mockDB)
+	r.NoError(err)
+	r.Len(references, 1)
+	r.Equal("orders", references[0].ObjectName.String)
+	r.Equal("amount", references[0].ColumnName.String)
+	r.Equal("finance", references[0].TagValue.String)
+	r.Equal(int64(42), references[0].ObjectID.Int64)
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/jmoiron/sqlx"
@@ -12,14 +13,15 @@ import (
 
 // ViewBuilder abstracts the creation of SQL queries for a Snowflake View.
 type ViewBuilder struct {
-	name      string
-	db        string
-	schema    string
-	secure    bool
-	replace   bool
-	comment   string
-	statement string
-	tags      []TagValue
+	name       string
+	db         string
+	schema     string
+	secure     bool
+	replace    bool
+	copyGrants bool
+	comment    string
+	statement  string
+	tags       []TagValue
 }
 
 // QualifiedName prepends the db and schema if set and escapes everything nicely.
@@ -49,6 +51,13 @@ func (vb *ViewBuilder) WithReplace() *ViewBuilder {
 	return vb
 }
 
+// WithCopyGrants adds the "COPY GRANTS" option to the ViewBuilder, which keeps the grants of the
+// replaced view. It only has an effect together with WithReplace.
+func (vb *ViewBuilder) WithCopyGrants() *ViewBuilder {
+	vb.copyGrants = true
+	return vb
+}
+
 // WithSchema adds the name of the schema to the ViewBuilder.
 func (vb *ViewBuilder) WithSchema(s string) *ViewBuilder {
 	vb.schema = s
@@ -129,6 +138,10 @@ func (vb *ViewBuilder) Create() (string, error) {
 
 	q.WriteString(fmt.Sprintf(` VIEW %v`, qn))
 
+	if vb.replace && vb.copyGrants {
+		q.WriteString(" COPY GRANTS")
+	}
+
 	if vb.comment != "" {
 		q.WriteString(fmt.Sprintf(" COMMENT = '%v'", EscapeString(vb.comment)))
 	}
@@ -171,6 +184,15 @@ func (vb *ViewBuilder) Unsecure() (string, error) {
 	return fmt.Sprintf(`ALTER VIEW %v UNSET SECURE`, qn), nil
 }
 
+// ChangeTracking returns the SQL query that will enable or disable change tracking on the view.
+func (vb *ViewBuilder) ChangeTracking(enabled bool) (string, error) {
+	qn, err := vb.QualifiedName()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`ALTER VIEW %v SET CHANGE_TRACKING = %v`, qn, strings.ToUpper(fmt.Sprintf("%t", enabled))), nil
+}
+
 // ChangeComment returns the SQL query that will update the comment on the view.
 // Note that comment is the only parameter, if more are released this should be
 // abstracted as per the generic builder.
@@ -199,6 +221,21 @@ func (vb *ViewBuilder) Show() string {
 	return fmt.Sprintf(`SHOW VIEWS LIKE '%v' IN SCHEMA "%v"."%v"`, vb.name, vb.db, vb.schema)
 }
 
+// ShowFromInformationSchema returns the SQL query that will read this view from the
+// INFORMATION_SCHEMA.VIEWS of its database, with the columns aliased like the ones of SHOW VIEWS.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/info-schema/views.html)
+func (vb *ViewBuilder) ShowFromInformationSchema() string {
+	return fmt.Sprintf(`SELECT TABLE_NAME AS "name", TABLE_SCHEMA AS "schema_name", TABLE_CATALOG AS "database_name", COMMENT AS "comment", VIEW_DEFINITION AS "text", IS_SECURE = 'YES' AS "is_secure" FROM "%v".INFORMATION_SCHEMA.VIEWS WHERE TABLE_SCHEMA = '%v' AND TABLE_NAME = '%v'`,
+		vb.db, EscapeString(vb.schema), EscapeString(vb.name))
+}
+
+// ShowMinimal returns the SQL query that will read only the name and comment of this view from the
+// INFORMATION_SCHEMA.VIEWS of its database, leaving out the view text.
+func (vb *ViewBuilder) ShowMinimal() string {
+	return fmt.Sprintf(`SELECT TABLE_NAME AS "name", TABLE_SCHEMA AS "schema_name", TABLE_CATALOG AS "database_name", COMMENT AS "comment" FROM "%v".INFORMATION_SCHEMA.VIEWS WHERE TABLE_SCHEMA = '%v' AND TABLE_NAME = '%v'`,
+		vb.db, EscapeString(vb.schema), EscapeString(vb.name))
+}
+
 // Drop returns the SQL query that will drop the row representing this view.
 func (vb *ViewBuilder) Drop() (string, error) {
 	qn, err := vb.QualifiedName()
@@ -208,13 +245,40 @@ func (vb *ViewBuilder) Drop() (string, error) {
 	return fmt.Sprintf(`DROP VIEW %v`, qn), nil
 }
 
+var (
+	viewStringLiteral = regexp.MustCompile(`'(?:[^'\\]|\\.)*'`)
+	viewGroupBy       = regexp.MustCompile(`(?i)\bGROUP\s+BY\b`)
+	viewDistinct      = regexp.MustCompile(`(?i)\bDISTINCT\b`)
+	viewWindow        = regexp.MustCompile(`(?i)\bOVER\s*\(`)
+)
+
+// ChangeTrackingIncompatibilities does a best-effort scan of a view statement for constructs
+// that streams on views do not support and returns a description of each one found. String
+// literals are ignored so that e.g. `WHERE note = 'group by'` does not trigger a false positive.
+// [Snowflake Reference](https://docs.snowflake.com/en/user-guide/streams-intro.html#streams-on-views)
+func ChangeTrackingIncompatibilities(statement string) []string {
+	s := viewStringLiteral.ReplaceAllString(statement, "''")
+	var found []string
+	if viewGroupBy.MatchString(s) {
+		found = append(found, "GROUP BY")
+	}
+	if viewDistinct.MatchString(s) {
+		found = append(found, "DISTINCT")
+	}
+	if viewWindow.MatchString(s) {
+		found = append(found, "window function (OVER)")
+	}
+	return found
+}
+
 type View struct {
-	Comment      sql.NullString `db:"comment"`
-	IsSecure     bool           `db:"is_secure"`
-	Name         sql.NullString `db:"name"`
-	SchemaName   sql.NullString `db:"schema_name"`
-	Text         sql.NullString `db:"text"`
-	DatabaseName sql.NullString `db:"database_name"`
+	Comment        sql.NullString `db:"comment"`
+	IsSecure       bool           `db:"is_secure"`
+	Name           sql.NullString `db:"name"`
+	SchemaName     sql.NullString `db:"schema_name"`
+	Text           sql.NullString `db:"text"`
+	DatabaseName   sql.NullString `db:"database_name"`
+	ChangeTracking sql.NullString `db:"change_tracking"`
 }
 
 func ScanView(row *sqlx.Row) (*View, error) {
@@ -242,3 +306,45 @@ func ListViews(databaseName string, schemaName string, db *sql.DB) ([]View, erro
 	}
 	return dbs, nil
 }
+
+// ObjectDependency is a row of SNOWFLAKE.ACCOUNT_USAGE.OBJECT_DEPENDENCIES describing an object
+// that references another one.
+type ObjectDependency struct {
+	DatabaseName sql.NullString `db:"referencing_database"`
+	SchemaName   sql.NullString `db:"referencing_schema"`
+	Name         sql.NullString `db:"referencing_object_name"`
+	Domain       sql.NullString `db:"referencing_object_domain"`
+}
+
+// Dependents returns the SQL query that will list the objects referencing this view. It reads
+// SNOWFLAKE.ACCOUNT_USAGE, which requires IMPORTED PRIVILEGES on the SNOWFLAKE database and lags
+// behind DDL by up to three hours.
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/account-usage/object_dependencies.html)
+func (vb *ViewBuilder) Dependents() string {
+	return fmt.Sprintf(`SELECT REFERENCING_DATABASE AS "referencing_database", REFERENCING_SCHEMA AS "referencing_schema", REFERENCING_OBJECT_NAME AS "referencing_object_name", REFERENCING_OBJECT_DOMAIN AS "referencing_object_domain" FROM SNOWFLAKE.ACCOUNT_USAGE.OBJECT_DEPENDENCIES WHERE REFERENCED_DATABASE = '%v' AND REFERENCED_SCHEMA = '%v' AND REFERENCED_OBJECT_NAME = '%v' AND REFERENCED_OBJECT_DOMAIN = 'VIEW' ORDER BY REFERENCING_DATABASE, REFERENCING_SCHEMA, REFERENCING_OBJECT_NAME`,
+		EscapeString(vb.db), EscapeString(vb.schema), EscapeString(vb.name))
+}
+
+// ListViewDependents returns the objects referencing the view built by vb.
+func ListViewDependents(vb *ViewBuilder, db *sql.DB) ([]ObjectDependency, error) {
+	stmt := vb.Dependents()
+	rows, err := Query(db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deps := []ObjectDependency{}
+	if err := sqlx.StructScan(rows, &deps); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+	}
+	return deps, nil
+}
+
+// QualifiedName returns the dotted name of the referencing object, e.g. DB.SCHEMA.NAME.
+func (od ObjectDependency) QualifiedName() string {
+	return fmt.Sprintf(`%v.%v.%v`, od.DatabaseName.String, od.SchemaName.String, od.Name.String)
+}
@@ -77,6 +77,21 @@ func TestView(t *testing.T) {
 	r.Equal(`DROP VIEW "mydb"."some_schema"."test"`, q)
 }
 
+func TestViewCopyGrants(t *testing.T) {
+	r := require.New(t)
+	v := NewViewBuilder("test").WithDB("db").WithSchema("schema").WithStatement("SELECT 1").WithCopyGrants()
+
+	// COPY GRANTS is only valid when replacing a view
+	q, err := v.Create()
+	r.NoError(err)
+	r.Equal(`CREATE VIEW "db"."schema"."test" AS SELECT 1`, q)
+
+	v.WithReplace().WithComment("c")
+	q, err = v.Create()
+	r.NoError(err)
+	r.Equal(`CREATE OR REPLACE VIEW "db"."schema"."test" COPY GRANTS COMMENT = 'c' AS SELECT 1`, q)
+}
+
 func TestQualifiedName(t *testing.T) {
 	r := require.New(t)
 	v := NewViewBuilder("view").WithDB("db").WithSchema("schema")
@@ -103,3 +118,55 @@ func TestRename(t *testing.T) {
 	r.NoError(err)
 	r.Equal(`ALTER VIEW "db"."testSchema"."test4" RENAME TO "db"."testSchema"."test5"`, q)
 }
+
+func TestViewChangeTracking(t *testing.T) {
+	r := require.New(t)
+	v := NewViewBuilder("test").WithDB("db").WithSchema("schema")
+
+	q, err := v.ChangeTracking(true)
+	r.NoError(err)
+	r.Equal(`ALTER VIEW "db"."schema"."test" SET CHANGE_TRACKING = TRUE`, q)
+
+	q, err = v.ChangeTracking(false)
+	r.NoError(err)
+	r.Equal(`ALTER VIEW "db"."schema"."test" SET CHANGE_TRACKING = FALSE`, q)
+}
+
+func TestViewCreateTableFunction(t *testing.T) {
+	r := require.New(t)
+	statement := "SELECT t.* FROM TABLE(my_udtf('a', (SELECT MAX(id) FROM src))) t"
+	v := NewViewBuilder("test").WithDB("db").WithSchema("schema").WithSecure().WithStatement(statement)
+
+	q, err := v.Create()
+	r.NoError(err)
+	r.Equal(`CREATE SECURE VIEW "db"."schema"."test" AS `+statement, q)
+
+	extracted, err := NewViewSelectStatementExtractor(q).Extract()
+	r.NoError(err)
+	r.Equal(statement, extracted)
+}
+
+func TestChangeTrackingIncompatibilities(t *testing.T) {
+	r := require.New(t)
+
+	r.Empty(ChangeTrackingIncompatibilities("SELECT id, name FROM t WHERE name = 'group by distinct'"))
+	r.Equal([]string{"GROUP BY"}, ChangeTrackingIncompatibilities("SELECT id, count(*) FROM t group\n by id"))
+	r.Equal([]string{"DISTINCT"}, ChangeTrackingIncompatibilities("SELECT DISTINCT id FROM t"))
+	r.Equal([]string{"window function (OVER)"}, ChangeTrackingIncompatibilities("SELECT row_number() over (partition by id) FROM t"))
+	r.Equal(
+		[]string{"GROUP BY", "DISTINCT", "window function (OVER)"},
+		ChangeTrackingIncompatibilities("SELECT count(DISTINCT a), sum(b) OVER(), c FROM t GROUP BY c"),
+	)
+}
+
+func TestViewShowFromInformationSchema(t *testing.T) {
+	r := require.New(t)
+	v := NewViewBuilder("test").WithDB("db").WithSchema("schema")
+	r.Equal(`SELECT TABLE_NAME AS "name", TABLE_SCHEMA AS "schema_name", TABLE_CATALOG AS "database_name", COMMENT AS "comment", VIEW_DEFINITION AS "text", IS_SECURE = 'YES' AS "is_secure" FROM "db".INFORMATION_SCHEMA.VIEWS WHERE TABLE_SCHEMA = 'schema' AND TABLE_NAME = 'test'`, v.ShowFromInformationSchema())
+}
+
+func TestViewShowMinimal(t *testing.T) {
+	r := require.New(t)
+	v := NewViewBuilder("test").WithDB("db").WithSchema("schema")
+	r.Equal(`SELECT TABLE_NAME AS "name", TABLE_SCHEMA AS "schema_name", TABLE_CATALOG AS "database_name", COMMENT AS "comment" FROM "db".INFORMATION_SCHEMA.VIEWS WHERE TABLE_SCHEMA = 'schema' AND TABLE_NAME = 'test'`, v.ShowMinimal())
+}
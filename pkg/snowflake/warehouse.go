@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/jmoiron/sqlx"
@@ -137,3 +138,13 @@ func ListWarehouses(db *sql.DB) ([]Warehouse, error) {
 	}
 	return dbs, nil
 }
+
+// IsSuspended reports whether the warehouse was suspended when it was shown.
+func (w *Warehouse) IsSuspended() bool {
+	return strings.EqualFold(w.State, "SUSPENDED")
+}
+
+// ShowWarehouse returns the SHOW WAREHOUSES row of the named warehouse.
+func ShowWarehouse(db *sql.DB, name string) (*Warehouse, error) {
+	return ScanWarehouse(QueryRow(db, NewWarehouseBuilder(name).Show()))
+}
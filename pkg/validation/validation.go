@@ -108,6 +108,34 @@ func ValidateAccountIdentifier(i interface{}, k string) (s []string, errors []er
 	return
 }
 
+// ValidateShareName validates the name of a share used as a grant target. Grants can only target
+// outbound shares, so the name is either a bare share name or the share name qualified with the
+// identifier of the providing account, e.g. my_share, orgname.accountname.my_share or
+// ab12345.my_share. Consumer accounts are added to the share itself, not to the grant.
+func ValidateShareName(i interface{}, k string) (s []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+	if strings.Contains(v, `"`) {
+		errors = append(errors, fmt.Errorf("%s must not be quoted, got %q", k, v))
+		return
+	}
+	parts := strings.Split(v, ".")
+	if len(parts) > 3 {
+		errors = append(errors, fmt.Errorf("%s must be share_name or account_identifier.share_name, got %q", k, v))
+		return
+	}
+	for _, part := range parts {
+		if strings.TrimSpace(part) == "" {
+			errors = append(errors, fmt.Errorf("%s must be share_name or account_identifier.share_name, got %q", k, v))
+			return
+		}
+	}
+	return
+}
+
 func ValidateEmail(i interface{}, k string) (s []string, errors []error) {
 	v, ok := i.(string)
 	if !ok {
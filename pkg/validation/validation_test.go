@@ -56,3 +56,15 @@ func TestValidateIsNotAccountLocator(t *testing.T) {
 		r.NotZero(len(errs), "account locators are not allowed - please use 'organization_name.account_name]", p, errs)
 	}
 }
+
+func TestValidateShareName(t *testing.T) {
+	r := require.New(t)
+	for _, v := range []string{"my_share", "ab12345.my_share", "orgname.accountname.my_share"} {
+		_, errs := ValidateShareName(v, "shares")
+		r.Empty(errs, v)
+	}
+	for _, v := range []string{"", "a.b.c.d", "account..share", ".my_share", `"my_share"`} {
+		_, errs := ValidateShareName(v, "shares")
+		r.NotEmpty(errs, v)
+	}
+}
@@ -0,0 +1,95 @@
+// Command privileges generates the table of valid privileges per object type used by the grant
+// resources from a CSV file of object_type,privilege rows.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+func main() {
+	in := flag.String("in", "privileges.csv", "CSV file of object_type,privilege rows")
+	out := flag.String("out", "privileges_generated.go", "Go file to write")
+	pkg := flag.String("package", "resources", "package of the generated file")
+	flag.Parse()
+
+	f, err := os.Open(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	privileges, err := readPrivileges(f)
+	if err != nil {
+		log.Fatalf("reading %v: %v", *in, err)
+	}
+	src, err := generate(*pkg, *in, privileges)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func readPrivileges(r io.Reader) (map[string][]string, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 2
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	privileges := map[string][]string{}
+	seen := map[string]bool{}
+	for i, record := range records {
+		objectType, privilege := strings.TrimSpace(record[0]), strings.TrimSpace(record[1])
+		if i == 0 && objectType == "object_type" {
+			continue
+		}
+		if objectType != strings.ToUpper(objectType) || privilege != strings.ToUpper(privilege) {
+			return nil, fmt.Errorf("line %d: object types and privileges must be upper case", i+1)
+		}
+		key := objectType + "|" + privilege
+		if seen[key] {
+			return nil, fmt.Errorf("line %d: duplicate privilege %v for %v", i+1, privilege, objectType)
+		}
+		seen[key] = true
+		privileges[objectType] = append(privileges[objectType], privilege)
+	}
+	return privileges, nil
+}
+
+func generate(pkg, source string, privileges map[string][]string) ([]byte, error) {
+	objectTypes := make([]string, 0, len(privileges))
+	for objectType := range privileges {
+		objectTypes = append(objectTypes, objectType)
+	}
+	sort.Strings(objectTypes)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by tools/privileges from %v; DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&b, "package %v\n\n", pkg)
+	b.WriteString("// objectPrivileges holds the privileges that can be granted on each object type.\n")
+	b.WriteString("var objectPrivileges = map[string]PrivilegeSet{\n")
+	for _, objectType := range objectTypes {
+		privs := privileges[objectType]
+		sort.Strings(privs)
+		fmt.Fprintf(&b, "\t%q: NewPrivilegeSet(\n", objectType)
+		for _, p := range privs {
+			fmt.Fprintf(&b, "\t\t%q,\n", p)
+		}
+		b.WriteString("\t),\n")
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}